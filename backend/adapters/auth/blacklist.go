@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBlacklist records revoked tokens by their unique ID until they would have expired anyway
+type TokenBlacklist interface {
+	Revoke(jti string, exp time.Time) error
+	IsRevoked(jti string) (bool, error)
+}
+
+// MemoryTokenBlacklist keeps revoked token IDs in process memory; revocations are lost on
+// restart and not shared between instances
+type MemoryTokenBlacklist struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+	now     func() time.Time
+}
+
+// NewMemoryTokenBlacklist creates an empty in-memory blacklist
+func NewMemoryTokenBlacklist() *MemoryTokenBlacklist {
+	return &MemoryTokenBlacklist{
+		revoked: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Revoke blacklists the token until exp, dropping entries whose tokens have since expired
+func (b *MemoryTokenBlacklist) Revoke(jti string, exp time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	for id, expiresAt := range b.revoked {
+		if !expiresAt.After(now) {
+			delete(b.revoked, id)
+		}
+	}
+
+	b.revoked[jti] = exp
+	return nil
+}
+
+// IsRevoked reports whether the token ID was revoked and has not yet expired
+func (b *MemoryTokenBlacklist) IsRevoked(jti string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	expiresAt, ok := b.revoked[jti]
+	return ok && expiresAt.After(b.now()), nil
+}
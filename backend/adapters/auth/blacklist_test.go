@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryTokenBlacklist(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	blacklist := NewMemoryTokenBlacklist()
+	blacklist.now = func() time.Time { return now }
+
+	require.NoError(t, blacklist.Revoke("short", now.Add(time.Minute)))
+	require.NoError(t, blacklist.Revoke("long", now.Add(time.Hour)))
+
+	revoked, err := blacklist.IsRevoked("short")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = blacklist.IsRevoked("unknown")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Once a token has expired on its own its entry no longer matters and is purged
+	now = now.Add(2 * time.Minute)
+	revoked, err = blacklist.IsRevoked("short")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, blacklist.Revoke("another", now.Add(time.Hour)))
+	assert.NotContains(t, blacklist.revoked, "short")
+	assert.Contains(t, blacklist.revoked, "long")
+}
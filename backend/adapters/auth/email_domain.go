@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrDisposableEmail is returned when an email address uses a blocked disposable domain
+	ErrDisposableEmail = errors.New("disposable email not allowed")
+	// ErrMalformedEmail is returned when no domain can be extracted from an email address
+	ErrMalformedEmail = errors.New("malformed email")
+)
+
+// EmailDomain returns the lower-cased domain part of an email address
+func EmailDomain(email string) (string, error) {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", ErrMalformedEmail
+	}
+
+	domain := strings.ToLower(strings.TrimSpace(email[at+1:]))
+	domain = strings.TrimSuffix(domain, ".")
+	if domain == "" || strings.ContainsAny(domain, " @") {
+		return "", ErrMalformedEmail
+	}
+
+	return domain, nil
+}
+
+// DisposableEmailChecker rejects email addresses on a configured list of disposable domains
+type DisposableEmailChecker struct {
+	domains map[string]struct{}
+}
+
+// NewDisposableEmailChecker creates a checker for the given domains; an empty list disables it
+func NewDisposableEmailChecker(domains []string) *DisposableEmailChecker {
+	blocked := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+		if domain != "" {
+			blocked[domain] = struct{}{}
+		}
+	}
+
+	return &DisposableEmailChecker{domains: blocked}
+}
+
+// Enabled reports whether any disposable domains are configured
+func (c *DisposableEmailChecker) Enabled() bool {
+	return len(c.domains) > 0
+}
+
+// Check returns ErrDisposableEmail when the email's domain, or any parent of it, is blocked
+func (c *DisposableEmailChecker) Check(email string) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	domain, err := EmailDomain(email)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if _, blocked := c.domains[domain]; blocked {
+			return ErrDisposableEmail
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			return nil
+		}
+		domain = domain[dot+1:]
+	}
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisposableEmailChecker_Check(t *testing.T) {
+	checker := NewDisposableEmailChecker([]string{"mailinator.com", " Trashmail.NET "})
+
+	tests := []struct {
+		name    string
+		email   string
+		wantErr error
+	}{
+		{
+			name:    "blocked disposable domain",
+			email:   "someone@mailinator.com",
+			wantErr: ErrDisposableEmail,
+		},
+		{
+			name:    "blocked domain is matched case-insensitively",
+			email:   "someone@TrashMail.net",
+			wantErr: ErrDisposableEmail,
+		},
+		{
+			name:    "subdomain of a blocked domain",
+			email:   "someone@eu.mailinator.com",
+			wantErr: ErrDisposableEmail,
+		},
+		{
+			name:    "allowed domain",
+			email:   "someone@example.com",
+			wantErr: nil,
+		},
+		{
+			name:    "lookalike domain is allowed",
+			email:   "someone@notmailinator.com",
+			wantErr: nil,
+		},
+		{
+			name:    "malformed email",
+			email:   "someone.mailinator.com",
+			wantErr: ErrMalformedEmail,
+		},
+		{
+			name:    "missing domain",
+			email:   "someone@",
+			wantErr: ErrMalformedEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checker.Check(tt.email)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDisposableEmailChecker_EmptyListDisablesCheck(t *testing.T) {
+	checker := NewDisposableEmailChecker(nil)
+
+	assert.False(t, checker.Enabled())
+	assert.NoError(t, checker.Check("someone@mailinator.com"))
+	assert.NoError(t, checker.Check("not-an-email"))
+}
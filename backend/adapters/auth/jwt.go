@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -8,18 +10,28 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Token types carried in the claims so one kind of token can't stand in for the other
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 // Claims represents JWT claims structure
 type Claims struct {
-	UserID   int    `json:"user_id"`
-	Username string `json:"username"`
-	Email    string `json:"email"`
+	UserID    int    `json:"user_id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Role      string `json:"role,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // TokenService defines the interface for JWT operations
 type TokenService interface {
-	GenerateToken(userID int, username, email string) (string, error)
+	GenerateToken(userID int, username, email, role string) (string, error)
 	ValidateToken(tokenString string) (*Claims, error)
+	GenerateRefreshToken(userID int) (string, error)
+	ValidateRefreshToken(tokenString string) (*Claims, error)
 }
 
 // PasswordService defines the interface for password operations
@@ -28,27 +40,59 @@ type PasswordService interface {
 	CheckPasswordHash(password, hash string) bool
 }
 
+const (
+	// DefaultTokenTTL is how long issued tokens stay valid unless configured otherwise
+	DefaultTokenTTL = 24 * time.Hour
+	// DefaultRefreshTokenTTL is how long refresh tokens stay valid unless configured otherwise
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
 // JWTService implements TokenService using JWT
 type JWTService struct {
-	secret string
+	secret     string
+	tokenTTL   time.Duration
+	refreshTTL time.Duration
 }
 
-// NewJWTService creates a new JWT service
+// NewJWTService creates a new JWT service issuing tokens valid for DefaultTokenTTL
 func NewJWTService(secret string) *JWTService {
+	return NewJWTServiceWithTTL(secret, DefaultTokenTTL)
+}
+
+// NewJWTServiceWithTTL creates a new JWT service issuing tokens valid for ttl;
+// a non-positive ttl falls back to DefaultTokenTTL
+func NewJWTServiceWithTTL(secret string, ttl time.Duration) *JWTService {
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
 	return &JWTService{
-		secret: secret,
+		secret:     secret,
+		tokenTTL:   ttl,
+		refreshTTL: DefaultRefreshTokenTTL,
 	}
 }
 
+// WithRefreshTTL sets how long refresh tokens stay valid; a non-positive ttl keeps the default
+func (s *JWTService) WithRefreshTTL(ttl time.Duration) *JWTService {
+	if ttl > 0 {
+		s.refreshTTL = ttl
+	}
+	return s
+}
+
 // GenerateToken generates a new JWT token
-func (s *JWTService) GenerateToken(userID int, username, email string) (string, error) {
+func (s *JWTService) GenerateToken(userID int, username, email, role string) (string, error) {
+	now := time.Now()
 	claims := &Claims{
-		UserID:   userID,
-		Username: username,
-		Email:    email,
+		UserID:    userID,
+		Username:  username,
+		Email:     email,
+		Role:      role,
+		TokenType: TokenTypeAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        newTokenID(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 
@@ -56,8 +100,62 @@ func (s *JWTService) GenerateToken(userID int, username, email string) (string,
 	return token.SignedString([]byte(s.secret))
 }
 
-// ValidateToken validates a JWT token and returns claims
+// ValidateToken validates a JWT access token and returns claims; refresh tokens are rejected.
+// Tokens issued before token types existed carry none and count as access tokens.
 func (s *JWTService) ValidateToken(tokenString string) (*Claims, error) {
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != "" && claims.TokenType != TokenTypeAccess {
+		return nil, fmt.Errorf("not an access token")
+	}
+
+	return claims, nil
+}
+
+// GenerateRefreshToken generates a long-lived token that can only be exchanged for access tokens
+func (s *JWTService) GenerateRefreshToken(userID int) (string, error) {
+	now := time.Now()
+	claims := &Claims{
+		UserID:    userID,
+		TokenType: TokenTypeRefresh,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        newTokenID(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.refreshTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.secret))
+}
+
+// ValidateRefreshToken validates a refresh token and returns its claims, including the user ID
+// it was issued to and its jti for revocation; access tokens are rejected
+func (s *JWTService) ValidateRefreshToken(tokenString string) (*Claims, error) {
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != TokenTypeRefresh {
+		return nil, fmt.Errorf("not a refresh token")
+	}
+
+	return claims, nil
+}
+
+// newTokenID returns a random identifier for the jti claim, so single tokens can be revoked
+func newTokenID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// parse checks the signature and expiry of a token of any type
+func (s *JWTService) parse(tokenString string) (*Claims, error) {
 	claims := &Claims{}
 	
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
@@ -79,16 +177,27 @@ func (s *JWTService) ValidateToken(tokenString string) (*Claims, error) {
 }
 
 // BCryptPasswordService implements PasswordService using bcrypt
-type BCryptPasswordService struct{}
+type BCryptPasswordService struct {
+	cost int
+}
 
-// NewBCryptPasswordService creates a new bcrypt password service
+// NewBCryptPasswordService creates a new bcrypt password service using bcrypt.DefaultCost
 func NewBCryptPasswordService() *BCryptPasswordService {
-	return &BCryptPasswordService{}
+	return &BCryptPasswordService{cost: bcrypt.DefaultCost}
+}
+
+// NewBCryptPasswordServiceWithCost creates a bcrypt password service hashing with the given cost.
+// Hashes made with any other cost still verify, since bcrypt stores the cost in the hash
+func NewBCryptPasswordServiceWithCost(cost int) (*BCryptPasswordService, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, cost)
+	}
+	return &BCryptPasswordService{cost: cost}, nil
 }
 
 // HashPassword hashes a password using bcrypt
 func (s *BCryptPasswordService) HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), s.cost)
 	return string(bytes), err
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestJWTService_GenerateToken(t *testing.T) {
@@ -40,7 +41,7 @@ func TestJWTService_GenerateToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			service := NewJWTService(tt.secret)
 			
-			token, err := service.GenerateToken(tt.userID, tt.username, tt.email)
+			token, err := service.GenerateToken(tt.userID, tt.username, tt.email, "admin")
 			
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -57,12 +58,74 @@ func TestJWTService_GenerateToken(t *testing.T) {
 			assert.Equal(t, tt.userID, claims.UserID)
 			assert.Equal(t, tt.username, claims.Username)
 			assert.Equal(t, tt.email, claims.Email)
+			assert.Equal(t, "admin", claims.Role)
 			assert.True(t, claims.ExpiresAt.Time.After(time.Now()))
 			assert.True(t, claims.IssuedAt.Time.Before(time.Now().Add(time.Second)))
 		})
 	}
 }
 
+func TestJWTService_GenerateToken_TTL(t *testing.T) {
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		wantTTL time.Duration
+	}{
+		{name: "one hour", ttl: time.Hour, wantTTL: time.Hour},
+		{name: "seven days", ttl: 7 * 24 * time.Hour, wantTTL: 7 * 24 * time.Hour},
+		{name: "unset falls back to default", ttl: 0, wantTTL: DefaultTokenTTL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewJWTServiceWithTTL("test-secret", tt.ttl)
+
+			token, err := service.GenerateToken(123, "testuser", "test@example.com", "user")
+			require.NoError(t, err)
+
+			claims, err := service.ValidateToken(token)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTTL, claims.ExpiresAt.Time.Sub(claims.IssuedAt.Time))
+		})
+	}
+}
+
+func TestJWTService_RefreshToken_TypeConfusion(t *testing.T) {
+	service := NewJWTService("test-secret")
+
+	accessToken, err := service.GenerateToken(123, "testuser", "test@example.com", "user")
+	require.NoError(t, err)
+	refreshToken, err := service.GenerateRefreshToken(123)
+	require.NoError(t, err)
+
+	refreshClaims, err := service.ValidateRefreshToken(refreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, 123, refreshClaims.UserID)
+	assert.NotEmpty(t, refreshClaims.ID)
+
+	_, err = service.ValidateRefreshToken(accessToken)
+	assert.Error(t, err, "access tokens must not refresh")
+
+	claims, err := service.ValidateToken(refreshToken)
+	assert.Error(t, err, "refresh tokens must not authenticate requests")
+	assert.Nil(t, claims)
+}
+
+func TestJWTService_RefreshToken_TTL(t *testing.T) {
+	service := NewJWTService("test-secret").WithRefreshTTL(7 * 24 * time.Hour)
+
+	token, err := service.GenerateRefreshToken(123)
+	require.NoError(t, err)
+
+	claims := &Claims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte("test-secret"), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TokenTypeRefresh, claims.TokenType)
+	assert.Equal(t, 7*24*time.Hour, claims.ExpiresAt.Time.Sub(claims.IssuedAt.Time))
+}
+
 func TestJWTService_ValidateToken(t *testing.T) {
 	secret := "test-secret"
 	service := NewJWTService(secret)
@@ -77,7 +140,7 @@ func TestJWTService_ValidateToken(t *testing.T) {
 			name:    "valid token",
 			wantErr: false,
 			setupFunc: func() string {
-				token, _ := service.GenerateToken(123, "testuser", "test@example.com")
+				token, _ := service.GenerateToken(123, "testuser", "test@example.com", "user")
 				return token
 			},
 		},
@@ -96,7 +159,7 @@ func TestJWTService_ValidateToken(t *testing.T) {
 			wantErr: true,
 			setupFunc: func() string {
 				wrongService := NewJWTService("wrong-secret")
-				token, _ := wrongService.GenerateToken(123, "testuser", "test@example.com")
+				token, _ := wrongService.GenerateToken(123, "testuser", "test@example.com", "user")
 				return token
 			},
 		},
@@ -219,6 +282,27 @@ func TestBCryptPasswordService_HashPassword(t *testing.T) {
 	}
 }
 
+func TestNewBCryptPasswordServiceWithCost(t *testing.T) {
+	_, err := NewBCryptPasswordServiceWithCost(bcrypt.MinCost - 1)
+	assert.Error(t, err)
+	_, err = NewBCryptPasswordServiceWithCost(bcrypt.MaxCost + 1)
+	assert.Error(t, err)
+
+	service, err := NewBCryptPasswordServiceWithCost(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	hash, err := service.HashPassword("testpassword123")
+	require.NoError(t, err)
+	cost, err := bcrypt.Cost([]byte(hash))
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost, cost)
+
+	// The cost is stored in the hash, so hashes made with another cost still verify
+	defaultHash, err := NewBCryptPasswordService().HashPassword("testpassword123")
+	require.NoError(t, err)
+	assert.True(t, service.CheckPasswordHash("testpassword123", defaultHash))
+}
+
 func TestBCryptPasswordService_CheckPasswordHash(t *testing.T) {
 	service := NewBCryptPasswordService()
 	password := "testpassword123"
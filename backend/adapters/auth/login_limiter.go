@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// LoginLimiter counts failed login attempts per key in fixed windows. State lives in process
+// memory, so it is lost on restart and not shared between instances
+type LoginLimiter struct {
+	mu          sync.Mutex
+	maxFailures int
+	window      time.Duration
+	attempts    map[string]*loginAttempts
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+type loginAttempts struct {
+	failures    int
+	windowStart time.Time
+}
+
+// NewLoginLimiter allows maxFailures failed attempts per key within each window
+func NewLoginLimiter(maxFailures int, window time.Duration) *LoginLimiter {
+	return &LoginLimiter{
+		maxFailures: maxFailures,
+		window:      window,
+		attempts:    make(map[string]*loginAttempts),
+		now:         time.Now,
+	}
+}
+
+// Allow reports whether key may attempt a login; when it may not, retryAfter is the time
+// left until its window ends
+func (l *LoginLimiter) Allow(key string) (allowed bool, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	a, ok := l.attempts[key]
+	if !ok || l.expired(a, now) || a.failures < l.maxFailures {
+		return true, 0
+	}
+	return false, a.windowStart.Add(l.window).Sub(now)
+}
+
+// RecordFailure counts a failed attempt for key, starting a new window when the previous one ended
+func (l *LoginLimiter) RecordFailure(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	a, ok := l.attempts[key]
+	if !ok || l.expired(a, now) {
+		l.attempts[key] = &loginAttempts{failures: 1, windowStart: now}
+		return
+	}
+	a.failures++
+}
+
+// Reset forgets the failures of key, e.g. after a successful login
+func (l *LoginLimiter) Reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.attempts, key)
+}
+
+func (l *LoginLimiter) expired(a *loginAttempts, now time.Time) bool {
+	return !now.Before(a.windowStart.Add(l.window))
+}
+
+// cleanup drops ended windows at most once per window so the map does not grow with every
+// client that ever failed a login
+func (l *LoginLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < l.window {
+		return
+	}
+	l.lastCleanup = now
+
+	for key, a := range l.attempts {
+		if l.expired(a, now) {
+			delete(l.attempts, key)
+		}
+	}
+}
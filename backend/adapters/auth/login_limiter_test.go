@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginLimiter(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	limiter := NewLoginLimiter(3, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		allowed, _ := limiter.Allow("10.0.0.1")
+		assert.True(t, allowed, "attempt %d", i+1)
+		limiter.RecordFailure("10.0.0.1")
+	}
+
+	now = now.Add(20 * time.Second)
+	allowed, retryAfter := limiter.Allow("10.0.0.1")
+	assert.False(t, allowed)
+	assert.Equal(t, 40*time.Second, retryAfter)
+
+	// Other clients are counted separately
+	allowed, _ = limiter.Allow("10.0.0.2")
+	assert.True(t, allowed)
+
+	// The block lifts once the window has passed
+	now = now.Add(40 * time.Second)
+	allowed, _ = limiter.Allow("10.0.0.1")
+	assert.True(t, allowed)
+	assert.NotContains(t, limiter.attempts, "10.0.0.1")
+}
+
+func TestLoginLimiter_Reset(t *testing.T) {
+	limiter := NewLoginLimiter(1, time.Minute)
+
+	limiter.RecordFailure("10.0.0.1")
+	allowed, _ := limiter.Allow("10.0.0.1")
+	assert.False(t, allowed)
+
+	limiter.Reset("10.0.0.1")
+	allowed, _ = limiter.Allow("10.0.0.1")
+	assert.True(t, allowed)
+}
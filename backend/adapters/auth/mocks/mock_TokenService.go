@@ -20,9 +20,65 @@ func (_m *MockTokenService) EXPECT() *MockTokenService_Expecter {
 	return &MockTokenService_Expecter{mock: &_m.Mock}
 }
 
-// GenerateToken provides a mock function with given fields: userID, username, email
-func (_m *MockTokenService) GenerateToken(userID int, username string, email string) (string, error) {
-	ret := _m.Called(userID, username, email)
+// GenerateRefreshToken provides a mock function with given fields: userID
+func (_m *MockTokenService) GenerateRefreshToken(userID int) (string, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRefreshToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (string, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) string); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenService_GenerateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateRefreshToken'
+type MockTokenService_GenerateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// GenerateRefreshToken is a helper method to define mock.On call
+//   - userID int
+func (_e *MockTokenService_Expecter) GenerateRefreshToken(userID interface{}) *MockTokenService_GenerateRefreshToken_Call {
+	return &MockTokenService_GenerateRefreshToken_Call{Call: _e.mock.On("GenerateRefreshToken", userID)}
+}
+
+func (_c *MockTokenService_GenerateRefreshToken_Call) Run(run func(userID int)) *MockTokenService_GenerateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockTokenService_GenerateRefreshToken_Call) Return(_a0 string, _a1 error) *MockTokenService_GenerateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenService_GenerateRefreshToken_Call) RunAndReturn(run func(int) (string, error)) *MockTokenService_GenerateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateToken provides a mock function with given fields: userID, username, email, role
+func (_m *MockTokenService) GenerateToken(userID int, username string, email string, role string) (string, error) {
+	ret := _m.Called(userID, username, email, role)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateToken")
@@ -30,17 +86,17 @@ func (_m *MockTokenService) GenerateToken(userID int, username string, email str
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, string, string) (string, error)); ok {
-		return rf(userID, username, email)
+	if rf, ok := ret.Get(0).(func(int, string, string, string) (string, error)); ok {
+		return rf(userID, username, email, role)
 	}
-	if rf, ok := ret.Get(0).(func(int, string, string) string); ok {
-		r0 = rf(userID, username, email)
+	if rf, ok := ret.Get(0).(func(int, string, string, string) string); ok {
+		r0 = rf(userID, username, email, role)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
-		r1 = rf(userID, username, email)
+	if rf, ok := ret.Get(1).(func(int, string, string, string) error); ok {
+		r1 = rf(userID, username, email, role)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +113,14 @@ type MockTokenService_GenerateToken_Call struct {
 //   - userID int
 //   - username string
 //   - email string
-func (_e *MockTokenService_Expecter) GenerateToken(userID interface{}, username interface{}, email interface{}) *MockTokenService_GenerateToken_Call {
-	return &MockTokenService_GenerateToken_Call{Call: _e.mock.On("GenerateToken", userID, username, email)}
+//   - role string
+func (_e *MockTokenService_Expecter) GenerateToken(userID interface{}, username interface{}, email interface{}, role interface{}) *MockTokenService_GenerateToken_Call {
+	return &MockTokenService_GenerateToken_Call{Call: _e.mock.On("GenerateToken", userID, username, email, role)}
 }
 
-func (_c *MockTokenService_GenerateToken_Call) Run(run func(userID int, username string, email string)) *MockTokenService_GenerateToken_Call {
+func (_c *MockTokenService_GenerateToken_Call) Run(run func(userID int, username string, email string, role string)) *MockTokenService_GenerateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string), args[2].(string))
+		run(args[0].(int), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -73,7 +130,65 @@ func (_c *MockTokenService_GenerateToken_Call) Return(_a0 string, _a1 error) *Mo
 	return _c
 }
 
-func (_c *MockTokenService_GenerateToken_Call) RunAndReturn(run func(int, string, string) (string, error)) *MockTokenService_GenerateToken_Call {
+func (_c *MockTokenService_GenerateToken_Call) RunAndReturn(run func(int, string, string, string) (string, error)) *MockTokenService_GenerateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateRefreshToken provides a mock function with given fields: tokenString
+func (_m *MockTokenService) ValidateRefreshToken(tokenString string) (*auth.Claims, error) {
+	ret := _m.Called(tokenString)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateRefreshToken")
+	}
+
+	var r0 *auth.Claims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*auth.Claims, error)); ok {
+		return rf(tokenString)
+	}
+	if rf, ok := ret.Get(0).(func(string) *auth.Claims); ok {
+		r0 = rf(tokenString)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Claims)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tokenString)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenService_ValidateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateRefreshToken'
+type MockTokenService_ValidateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// ValidateRefreshToken is a helper method to define mock.On call
+//   - tokenString string
+func (_e *MockTokenService_Expecter) ValidateRefreshToken(tokenString interface{}) *MockTokenService_ValidateRefreshToken_Call {
+	return &MockTokenService_ValidateRefreshToken_Call{Call: _e.mock.On("ValidateRefreshToken", tokenString)}
+}
+
+func (_c *MockTokenService_ValidateRefreshToken_Call) Run(run func(tokenString string)) *MockTokenService_ValidateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenService_ValidateRefreshToken_Call) Return(_a0 *auth.Claims, _a1 error) *MockTokenService_ValidateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenService_ValidateRefreshToken_Call) RunAndReturn(run func(string) (*auth.Claims, error)) *MockTokenService_ValidateRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy describes the rules new passwords must satisfy
+type PasswordPolicy struct {
+	MinLength      int
+	RequireDigit   bool
+	RequireUpper   bool
+	RequireSpecial bool
+}
+
+// DefaultPasswordPolicy only requires at least 6 characters
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{MinLength: 6}
+}
+
+// ValidatePassword returns a description of each rule of the policy the password fails, or
+// nil when it satisfies them all
+func ValidatePassword(password string, policy PasswordPolicy) []string {
+	var hasDigit, hasUpper, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSpecial = true
+		}
+	}
+
+	var failed []string
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		failed = append(failed, fmt.Sprintf("must be at least %d characters", policy.MinLength))
+	}
+	if policy.RequireDigit && !hasDigit {
+		failed = append(failed, "must contain a digit")
+	}
+	if policy.RequireUpper && !hasUpper {
+		failed = append(failed, "must contain an uppercase letter")
+	}
+	if policy.RequireSpecial && !hasSpecial {
+		failed = append(failed, "must contain a special character")
+	}
+	return failed
+}
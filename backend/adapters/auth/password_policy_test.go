@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePassword(t *testing.T) {
+	strict := PasswordPolicy{MinLength: 8, RequireDigit: true, RequireUpper: true, RequireSpecial: true}
+
+	tests := []struct {
+		name     string
+		password string
+		policy   PasswordPolicy
+		want     []string
+	}{
+		{
+			name:     "default policy accepts 6 characters",
+			password: "secret",
+			policy:   DefaultPasswordPolicy(),
+			want:     nil,
+		},
+		{
+			name:     "default policy rejects 5 characters",
+			password: "short",
+			policy:   DefaultPasswordPolicy(),
+			want:     []string{"must be at least 6 characters"},
+		},
+		{
+			name:     "length counts characters, not bytes",
+			password: "ñññññ",
+			policy:   DefaultPasswordPolicy(),
+			want:     []string{"must be at least 6 characters"},
+		},
+		{
+			name:     "strict policy accepts a strong password",
+			password: "Corr3ct-horse",
+			policy:   strict,
+			want:     nil,
+		},
+		{
+			name:     "strict policy lists every failed rule",
+			password: "weak",
+			policy:   strict,
+			want: []string{
+				"must be at least 8 characters",
+				"must contain a digit",
+				"must contain an uppercase letter",
+				"must contain a special character",
+			},
+		},
+		{
+			name:     "missing special character only",
+			password: "Password123",
+			policy:   strict,
+			want:     []string{"must contain a special character"},
+		},
+		{
+			name:     "spaces are not special characters",
+			password: "Pass word 123",
+			policy:   strict,
+			want:     []string{"must contain a special character"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidatePassword(tt.password, tt.policy))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVerificationToken(t *testing.T) {
+	token, hash, err := NewVerificationToken()
+	require.NoError(t, err)
+
+	assert.Len(t, token, 64)
+	assert.Len(t, hash, 64)
+	assert.NotEqual(t, token, hash)
+	assert.Equal(t, hash, HashVerificationToken(token))
+
+	other, _, err := NewVerificationToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
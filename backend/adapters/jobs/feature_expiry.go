@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
+)
+
+// FeatureExpiryJob periodically marks features whose voting deadline has passed as expired
+type FeatureExpiryJob struct {
+	featureRepo features.Repository
+	interval    time.Duration
+	logger      logs.Logger
+	now         func() time.Time
+}
+
+// NewFeatureExpiryJob creates a job that checks for expired features every interval
+func NewFeatureExpiryJob(featureRepo features.Repository, interval time.Duration, logger logs.Logger) *FeatureExpiryJob {
+	return &FeatureExpiryJob{
+		featureRepo: featureRepo,
+		interval:    interval,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// RunOnce expires every feature whose deadline is at or before the current time
+func (j *FeatureExpiryJob) RunOnce() (int, error) {
+	expired, err := j.featureRepo.ExpireFeatures(j.now())
+	if err != nil {
+		j.logger.Error("Failed to expire features", err)
+		return 0, err
+	}
+
+	if expired > 0 {
+		j.logger.Info("Expired features past their voting deadline",
+			logs.WithMetadata("expired_count", expired))
+	}
+
+	return expired, nil
+}
+
+// Run checks for expired features immediately and then every interval until ctx is cancelled
+func (j *FeatureExpiryJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
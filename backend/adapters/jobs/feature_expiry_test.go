@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFeatureExpiryJob_RunOnce(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		wantExpired int
+		wantErr     bool
+	}{
+		{
+			name: "flips features past their deadline",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("ExpireFeatures", now).Return(2, nil)
+				logger.On("Info", "Expired features past their voting deadline", mock.Anything).Return()
+			},
+			wantExpired: 2,
+		},
+		{
+			name: "nothing to expire stays quiet",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("ExpireFeatures", now).Return(0, nil)
+			},
+			wantExpired: 0,
+		},
+		{
+			name: "database error is logged and returned",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("ExpireFeatures", now).Return(0, fmt.Errorf("connection refused"))
+				logger.On("Error", "Failed to expire features", mock.Anything).Return()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			job := NewFeatureExpiryJob(repo, time.Minute, logger)
+			job.now = func() time.Time { return now }
+
+			tt.setupMocks(repo, logger)
+
+			expired, err := job.RunOnce()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantExpired, expired)
+		})
+	}
+}
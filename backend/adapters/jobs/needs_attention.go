@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
+)
+
+// NeedsAttentionJob periodically collects open features that are older than a minimum age
+// and still have few votes, keeping the latest digest in memory for moderators
+type NeedsAttentionJob struct {
+	featureRepo features.Repository
+	interval    time.Duration
+	minAge      time.Duration
+	maxVotes    int
+	logger      logs.Logger
+	now         func() time.Time
+
+	mu     sync.RWMutex
+	digest *features.AttentionDigest
+}
+
+// NewNeedsAttentionJob creates a job that every interval collects features at least minAge
+// old with maxVotes or fewer votes
+func NewNeedsAttentionJob(featureRepo features.Repository, interval, minAge time.Duration, maxVotes int, logger logs.Logger) *NeedsAttentionJob {
+	return &NeedsAttentionJob{
+		featureRepo: featureRepo,
+		interval:    interval,
+		minAge:      minAge,
+		maxVotes:    maxVotes,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// RunOnce rebuilds the digest; on failure the previous digest is kept
+func (j *NeedsAttentionJob) RunOnce() (features.AttentionDigest, error) {
+	now := j.now()
+	stale, err := j.featureRepo.GetStaleFeatures(now.Add(-j.minAge), j.maxVotes)
+	if err != nil {
+		j.logger.Error("Failed to collect features needing attention", err)
+		return features.AttentionDigest{}, err
+	}
+
+	digest := features.AttentionDigest{
+		GeneratedAt: now,
+		MinAgeDays:  int(j.minAge / (24 * time.Hour)),
+		MaxVotes:    j.maxVotes,
+		Features:    stale,
+	}
+
+	j.mu.Lock()
+	j.digest = &digest
+	j.mu.Unlock()
+
+	j.logger.Debug("Rebuilt needs-attention digest",
+		logs.WithMetadata("feature_count", len(stale)))
+
+	return digest, nil
+}
+
+// Digest returns the most recent digest, and false until the first successful run
+func (j *NeedsAttentionJob) Digest() (features.AttentionDigest, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	if j.digest == nil {
+		return features.AttentionDigest{}, false
+	}
+	return *j.digest, true
+}
+
+// Run rebuilds the digest immediately and then every interval until ctx is cancelled
+func (j *NeedsAttentionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
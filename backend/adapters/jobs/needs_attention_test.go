@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/features"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNeedsAttentionJob_RunOnce(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-30 * 24 * time.Hour)
+
+	repo := featuresmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	job := NewNeedsAttentionJob(repo, time.Hour, 30*24*time.Hour, 2, logger)
+	job.now = func() time.Time { return now }
+
+	_, ready := job.Digest()
+	assert.False(t, ready)
+
+	stale := []features.Feature{{ID: 3, VoteCount: 0}, {ID: 1, VoteCount: 2}}
+	repo.On("GetStaleFeatures", cutoff, 2).Return(stale, nil).Once()
+	logger.On("Debug", "Rebuilt needs-attention digest", mock.Anything).Return()
+
+	digest, err := job.RunOnce()
+	require.NoError(t, err)
+	assert.Equal(t, now, digest.GeneratedAt)
+	assert.Equal(t, 30, digest.MinAgeDays)
+	assert.Equal(t, 2, digest.MaxVotes)
+	assert.Equal(t, stale, digest.Features)
+
+	// A failed rebuild keeps serving the previous digest
+	repo.On("GetStaleFeatures", cutoff, 2).Return(nil, fmt.Errorf("connection refused")).Once()
+	logger.On("Error", "Failed to collect features needing attention", mock.Anything).Return()
+
+	_, err = job.RunOnce()
+	assert.Error(t, err)
+
+	current, ready := job.Digest()
+	assert.True(t, ready)
+	assert.Equal(t, digest, current)
+}
@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
+)
+
+// VoteSnapshotJob periodically records every feature's vote count for the current day, so
+// rank history can be reconstructed later
+type VoteSnapshotJob struct {
+	featureRepo features.Repository
+	interval    time.Duration
+	logger      logs.Logger
+}
+
+// NewVoteSnapshotJob creates a job that refreshes today's vote snapshot every interval
+func NewVoteSnapshotJob(featureRepo features.Repository, interval time.Duration, logger logs.Logger) *VoteSnapshotJob {
+	return &VoteSnapshotJob{
+		featureRepo: featureRepo,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// RunOnce snapshots the current vote count of every feature
+func (j *VoteSnapshotJob) RunOnce() (int, error) {
+	recorded, err := j.featureRepo.SnapshotVoteCounts()
+	if err != nil {
+		j.logger.Error("Failed to snapshot vote counts", err)
+		return 0, err
+	}
+
+	j.logger.Debug("Snapshotted feature vote counts",
+		logs.WithMetadata("feature_count", recorded))
+
+	return recorded, nil
+}
+
+// Run snapshots immediately and then every interval until ctx is cancelled; the last run of
+// each day leaves that day's final counts
+func (j *VoteSnapshotJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
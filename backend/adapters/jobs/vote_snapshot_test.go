@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVoteSnapshotJob_RunOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupMocks   func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		wantRecorded int
+		wantErr      bool
+	}{
+		{
+			name: "snapshots every feature",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("SnapshotVoteCounts").Return(12, nil)
+				logger.On("Debug", "Snapshotted feature vote counts", mock.Anything).Return()
+			},
+			wantRecorded: 12,
+		},
+		{
+			name: "repository error is logged",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("SnapshotVoteCounts").Return(0, fmt.Errorf("connection refused"))
+				logger.On("Error", "Failed to snapshot vote counts", mock.Anything).Return()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			tt.setupMocks(repo, logger)
+
+			job := NewVoteSnapshotJob(repo, time.Hour, logger)
+			recorded, err := job.RunOnce()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRecorded, recorded)
+			}
+		})
+	}
+}
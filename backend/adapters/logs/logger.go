@@ -2,8 +2,12 @@ package logs
 
 import (
 	"encoding/json"
+	"io"
 	"log"
+	"os"
 	"runtime"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,11 +21,33 @@ const (
 	LogLevelDebug   LogLevel = "DEBUG"
 )
 
+// levelRanks orders the levels from most to least verbose
+var levelRanks = map[LogLevel]int{
+	LogLevelDebug:   0,
+	LogLevelInfo:    1,
+	LogLevelWarning: 2,
+	LogLevelError:   3,
+}
+
+// ParseLogLevel converts a case-insensitive level name, reporting false for unknown names
+func ParseLogLevel(s string) (LogLevel, bool) {
+	level := LogLevel(strings.ToUpper(strings.TrimSpace(s)))
+	if _, ok := levelRanks[level]; !ok {
+		return "", false
+	}
+	return level, true
+}
+
+// CategorySecurity tags authentication and authorization events so they can be routed separately
+const CategorySecurity = "security"
+
 // LogEntry represents a structured log entry
 type LogEntry struct {
 	Timestamp  time.Time              `json:"timestamp"`
 	Level      LogLevel               `json:"level"`
 	Message    string                 `json:"message"`
+	Category   string                 `json:"category,omitempty"`
+	RequestID  string                 `json:"request_id,omitempty"`
 	UserID     *int                   `json:"user_id,omitempty"`
 	FeatureID  *int                   `json:"feature_id,omitempty"`
 	VoteCount  *int                   `json:"vote_count,omitempty"`
@@ -44,44 +70,79 @@ type Logger interface {
 }
 
 // JSONLogger implements Logger interface with JSON structured logging
-type JSONLogger struct{}
+type JSONLogger struct {
+	minRank int
+	out     io.Writer
+	mu      sync.Mutex
+}
 
-// NewJSONLogger creates a new JSON logger
-func NewJSONLogger() *JSONLogger {
-	return &JSONLogger{}
+// NewJSONLogger creates a new JSON logger writing to stdout that drops entries below the
+// given level; an unknown level keeps every entry
+func NewJSONLogger(level LogLevel) *JSONLogger {
+	return NewJSONLoggerWithWriter(os.Stdout, level)
+}
+
+// NewJSONLoggerWithWriter creates a JSON logger writing one entry per line to w, e.g. a
+// file or a buffer in tests
+func NewJSONLoggerWithWriter(w io.Writer, level LogLevel) *JSONLogger {
+	return &JSONLogger{minRank: levelRanks[level], out: w}
+}
+
+// enabled reports whether entries at level pass the logger's threshold
+func (l *JSONLogger) enabled(level LogLevel) bool {
+	return levelRanks[level] >= l.minRank
 }
 
 // Info logs an info message
 func (l *JSONLogger) Info(message string, fields ...LogField) {
+	if !l.enabled(LogLevelInfo) {
+		return
+	}
 	logEntry := createLogEntry(LogLevelInfo, message, fields...)
-	outputLog(logEntry)
+	l.output(logEntry)
 }
 
 // Warning logs a warning message
 func (l *JSONLogger) Warning(message string, fields ...LogField) {
+	if !l.enabled(LogLevelWarning) {
+		return
+	}
 	logEntry := createLogEntry(LogLevelWarning, message, fields...)
-	outputLog(logEntry)
+	l.output(logEntry)
 }
 
 // Error logs an error message with stack trace
 func (l *JSONLogger) Error(message string, err error, fields ...LogField) {
+	if !l.enabled(LogLevelError) {
+		return
+	}
 	logEntry := createLogEntry(LogLevelError, message, fields...)
 	if err != nil {
 		logEntry.Error = err.Error()
 	}
 	logEntry.StackTrace = getStackTrace()
-	outputLog(logEntry)
+	l.output(logEntry)
 }
 
 // Debug logs a debug message
 func (l *JSONLogger) Debug(message string, fields ...LogField) {
+	if !l.enabled(LogLevelDebug) {
+		return
+	}
 	logEntry := createLogEntry(LogLevelDebug, message, fields...)
-	outputLog(logEntry)
+	l.output(logEntry)
 }
 
 // LogField is a function that modifies a log entry
 type LogField func(*LogEntry)
 
+// WithRequestID adds the ID correlating all entries of one HTTP request
+func WithRequestID(requestID string) LogField {
+	return func(entry *LogEntry) {
+		entry.RequestID = requestID
+	}
+}
+
 // WithUserID adds user ID to log entry
 func WithUserID(userID int) LogField {
 	return func(entry *LogEntry) {
@@ -138,6 +199,13 @@ func WithStatusCode(statusCode int) LogField {
 	}
 }
 
+// WithCategory adds a category used to route log entries, e.g. CategorySecurity
+func WithCategory(category string) LogField {
+	return func(entry *LogEntry) {
+		entry.Category = category
+	}
+}
+
 // WithMetadata adds custom metadata to log entry
 func WithMetadata(key string, value interface{}) LogField {
 	return func(entry *LogEntry) {
@@ -162,13 +230,20 @@ func createLogEntry(level LogLevel, message string, fields ...LogField) *LogEntr
 	return entry
 }
 
-func outputLog(entry *LogEntry) {
+// output writes the entry as a single JSON line; the lock keeps concurrent entries from
+// interleaving on writers that are not safe for concurrent use
+func (l *JSONLogger) output(entry *LogEntry) {
 	jsonBytes, err := json.Marshal(entry)
 	if err != nil {
 		log.Printf("Error marshalling log entry: %v", err)
 		return
 	}
-	log.Println(string(jsonBytes))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.out.Write(append(jsonBytes, '\n')); err != nil {
+		log.Printf("Error writing log entry: %v", err)
+	}
 }
 
 func getStackTrace() string {
@@ -0,0 +1,96 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLogger_MinimumLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   LogLevel
+		kept    []string
+		dropped []string
+	}{
+		{
+			name:    "warning drops info and debug",
+			level:   LogLevelWarning,
+			kept:    []string{"warning entry", "error entry"},
+			dropped: []string{"debug entry", "info entry"},
+		},
+		{
+			name:  "debug keeps everything",
+			level: LogLevelDebug,
+			kept:  []string{"debug entry", "info entry", "warning entry", "error entry"},
+		},
+		{
+			name:    "error keeps only errors",
+			level:   LogLevelError,
+			kept:    []string{"error entry"},
+			dropped: []string{"debug entry", "info entry", "warning entry"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := NewJSONLoggerWithWriter(&buf, tt.level)
+
+			logger.Debug("debug entry")
+			logger.Info("info entry")
+			logger.Warning("warning entry")
+			logger.Error("error entry", errors.New("boom"))
+			output := buf.String()
+
+			for _, message := range tt.kept {
+				assert.Contains(t, output, message)
+			}
+			for _, message := range tt.dropped {
+				assert.NotContains(t, output, message)
+			}
+			assert.Equal(t, len(tt.kept), strings.Count(output, "\n"))
+		})
+	}
+}
+
+func TestParseLogLevel(t *testing.T) {
+	level, ok := ParseLogLevel(" warning ")
+	assert.True(t, ok)
+	assert.Equal(t, LogLevelWarning, level)
+
+	_, ok = ParseLogLevel("verbose")
+	assert.False(t, ok)
+}
+
+func TestJSONLogger_WritesStructuredFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLoggerWithWriter(&buf, LogLevelDebug)
+
+	logger.Error("Failed to add vote", errors.New("connection reset"),
+		WithUserID(7),
+		WithFeatureID(3),
+		WithMethod("POST"),
+		WithPath("/api/v1/features/3/vote"),
+		WithStatusCode(500),
+		WithMetadata("category", "default"))
+
+	var entry LogEntry
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, LogLevelError, entry.Level)
+	assert.Equal(t, "Failed to add vote", entry.Message)
+	assert.Equal(t, "connection reset", entry.Error)
+	assert.Equal(t, 7, *entry.UserID)
+	assert.Equal(t, 3, *entry.FeatureID)
+	assert.Equal(t, "POST", entry.Method)
+	assert.Equal(t, "/api/v1/features/3/vote", entry.Path)
+	assert.Equal(t, 500, *entry.StatusCode)
+	assert.Equal(t, "default", entry.Metadata["category"])
+	assert.NotEmpty(t, entry.StackTrace)
+	assert.True(t, strings.HasSuffix(buf.String(), "}\n"))
+}
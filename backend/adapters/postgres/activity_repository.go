@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/activity"
+)
+
+// ActivityRepository implements activity.Repository
+type ActivityRepository struct {
+	db *DB
+}
+
+// NewActivityRepository creates a new activity repository
+func NewActivityRepository(db *DB) *ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+// Record appends an event to the activity stream; a vote milestone already recorded for the
+// feature is ignored
+func (r *ActivityRepository) Record(event activity.Event) error {
+	query := `
+		INSERT INTO activity_events (type, actor_id, feature_id, milestone)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (feature_id, milestone) WHERE type = 'vote_milestone' DO NOTHING
+	`
+
+	_, err := r.db.Exec(query, event.Type, event.ActorID, event.FeatureID, event.Milestone)
+	if err != nil {
+		return fmt.Errorf("failed to record activity event: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves a page of the activity stream, newest event first
+func (r *ActivityRepository) List(page, perPage int) ([]activity.Event, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM activity_events`).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get activity count: %w", err)
+	}
+
+	query := `
+		SELECT e.id, e.type, e.actor_id, u.username, e.feature_id, f.title, e.milestone, e.created_at
+		FROM activity_events e
+		JOIN features f ON f.id = e.feature_id
+		LEFT JOIN users u ON u.id = e.actor_id
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.Query(query, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get activity events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []activity.Event
+	for rows.Next() {
+		var event activity.Event
+		err := rows.Scan(
+			&event.ID, &event.Type, &event.ActorID, &event.ActorUsername,
+			&event.FeatureID, &event.FeatureTitle, &event.Milestone, &event.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan activity event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating activity events: %w", err)
+	}
+
+	return events, total, nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/feature-voting-platform/backend/domain/activity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityRepository_Record(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewActivityRepository(&DB{db})
+	actorID := 3
+	milestone := 25
+
+	mock.ExpectExec(`INSERT INTO activity_events \(type, actor_id, feature_id, milestone\)\s+VALUES \(\$1, \$2, \$3, \$4\)\s+ON CONFLICT \(feature_id, milestone\) WHERE type = 'vote_milestone' DO NOTHING`).
+		WithArgs(activity.TypeVoteMilestone, 3, 7, 25).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Record(activity.Event{
+		Type:      activity.TypeVoteMilestone,
+		ActorID:   &actorID,
+		FeatureID: 7,
+		Milestone: &milestone,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestActivityRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewActivityRepository(&DB{db})
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		page      int
+		perPage   int
+		setup     func()
+		wantTypes []string
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name:    "pages newest first",
+			page:    2,
+			perPage: 2,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM activity_events`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+				rows := sqlmock.NewRows([]string{"id", "type", "actor_id", "username", "feature_id", "title", "milestone", "created_at"}).
+					AddRow(3, activity.TypeVoteMilestone, 2, "alice", 7, "Dark mode", 10, now).
+					AddRow(2, activity.TypeFeatureCreated, 1, "bob", 7, "Dark mode", nil, now.Add(-time.Hour))
+				mock.ExpectQuery(`FROM activity_events e\s+JOIN features f ON f.id = e.feature_id\s+LEFT JOIN users u ON u.id = e.actor_id\s+ORDER BY e.created_at DESC, e.id DESC\s+LIMIT \$1 OFFSET \$2`).
+					WithArgs(2, 2).
+					WillReturnRows(rows)
+			},
+			wantTypes: []string{activity.TypeVoteMilestone, activity.TypeFeatureCreated},
+			wantTotal: 5,
+		},
+		{
+			name:    "count query error",
+			page:    1,
+			perPage: 10,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM activity_events`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			events, total, err := repo.List(tt.page, tt.perPage)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantTotal, total)
+				require.Len(t, events, len(tt.wantTypes))
+				for i, wantType := range tt.wantTypes {
+					assert.Equal(t, wantType, events[i].Type)
+				}
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
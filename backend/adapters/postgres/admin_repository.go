@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/admin"
+)
+
+// AdminRepository implements admin.Repository
+type AdminRepository struct {
+	db *DB
+}
+
+// NewAdminRepository creates a new admin repository
+func NewAdminRepository(db *DB) *AdminRepository {
+	return &AdminRepository{db: db}
+}
+
+// GetStats counts features, votes and users, and lists features whose stored vote count
+// has drifted from the sum of their vote values. It never modifies data; the recount
+// command is what heals the drift
+func (r *AdminRepository) GetStats() (*admin.Stats, error) {
+	countsQuery := `
+		SELECT
+			(SELECT COUNT(*) FROM features WHERE deleted_at IS NULL),
+			(SELECT COUNT(*) FROM features WHERE deleted_at IS NOT NULL),
+			(SELECT COUNT(*) FROM votes),
+			(SELECT COUNT(*) FROM users)
+	`
+
+	stats := &admin.Stats{}
+	err := r.db.QueryRow(countsQuery).Scan(&stats.Features, &stats.DeletedFeatures, &stats.Votes, &stats.Users)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get counts: %w", err)
+	}
+
+	mismatchQuery := `
+		SELECT f.id, f.title, f.vote_count, COALESCE(SUM(v.value), 0) AS actual
+		FROM features f
+		LEFT JOIN votes v ON v.feature_id = f.id
+		GROUP BY f.id
+		HAVING f.vote_count <> COALESCE(SUM(v.value), 0)
+		ORDER BY f.id
+	`
+
+	rows, err := r.db.Query(mismatchQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote count mismatches: %w", err)
+	}
+	defer rows.Close()
+
+	stats.VoteCountMismatches = []admin.VoteCountMismatch{}
+	for rows.Next() {
+		var m admin.VoteCountMismatch
+		if err := rows.Scan(&m.FeatureID, &m.Title, &m.StoredCount, &m.ActualCount); err != nil {
+			return nil, fmt.Errorf("failed to scan vote count mismatch: %w", err)
+		}
+		stats.VoteCountMismatches = append(stats.VoteCountMismatches, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vote count mismatches: %w", err)
+	}
+
+	return stats, nil
+}
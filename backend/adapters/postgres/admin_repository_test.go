@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/feature-voting-platform/backend/domain/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminRepository_GetStats(t *testing.T) {
+	countsQuery := `SELECT \(SELECT COUNT\(\*\) FROM features WHERE deleted_at IS NULL\), \(SELECT COUNT\(\*\) FROM features WHERE deleted_at IS NOT NULL\), \(SELECT COUNT\(\*\) FROM votes\), \(SELECT COUNT\(\*\) FROM users\)`
+	mismatchQuery := `SELECT f.id, f.title, f.vote_count, COALESCE\(SUM\(v.value\), 0\) AS actual FROM features f LEFT JOIN votes v ON v.feature_id = f.id GROUP BY f.id HAVING f.vote_count <> COALESCE\(SUM\(v.value\), 0\) ORDER BY f.id`
+
+	t.Run("reports counts and mismatches", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewAdminRepository(&DB{db})
+
+		mock.ExpectQuery(countsQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"features", "deleted", "votes", "users"}).AddRow(12, 2, 40, 7))
+		mock.ExpectQuery(mismatchQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "vote_count", "actual"}).
+				AddRow(3, "Dark mode", 5, 4))
+
+		stats, err := repo.GetStats()
+
+		require.NoError(t, err)
+		assert.Equal(t, &admin.Stats{
+			Features:        12,
+			DeletedFeatures: 2,
+			Votes:           40,
+			Users:           7,
+			VoteCountMismatches: []admin.VoteCountMismatch{
+				{FeatureID: 3, Title: "Dark mode", StoredCount: 5, ActualCount: 4},
+			},
+		}, stats)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no mismatches", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewAdminRepository(&DB{db})
+
+		mock.ExpectQuery(countsQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"features", "deleted", "votes", "users"}).AddRow(0, 0, 0, 0))
+		mock.ExpectQuery(mismatchQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "vote_count", "actual"}))
+
+		stats, err := repo.GetStats()
+
+		require.NoError(t, err)
+		assert.NotNil(t, stats.VoteCountMismatches)
+		assert.Empty(t, stats.VoteCountMismatches)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewAdminRepository(&DB{db})
+
+		mock.ExpectQuery(countsQuery).WillReturnError(errors.New("connection refused"))
+
+		stats, err := repo.GetStats()
+
+		assert.Error(t, err)
+		assert.Nil(t, stats)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
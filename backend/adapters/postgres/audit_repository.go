@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/audit"
+)
+
+// AuditRepository implements audit.Repository
+type AuditRepository struct {
+	db *DB
+}
+
+// NewAuditRepository creates a new audit repository
+func NewAuditRepository(db *DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Begin writes the entry for a request that is about to be handled and sets its ID
+func (r *AuditRepository) Begin(entry *audit.Entry) error {
+	query := `
+		INSERT INTO audit_log (method, path, body_hash)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRow(query, entry.Method, entry.Path, entry.BodyHash).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to begin audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// Complete records who made the request and how it ended
+func (r *AuditRepository) Complete(id int, actorID *int, status int) error {
+	query := `
+		UPDATE audit_log
+		SET actor_id = $2, status = $3, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+
+	_, err := r.db.Exec(query, id, actorID, status)
+	if err != nil {
+		return fmt.Errorf("failed to complete audit entry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/feature-voting-platform/backend/domain/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepository_BeginAndComplete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAuditRepository(&DB{db})
+	now := time.Now()
+	actorID := 42
+
+	mock.ExpectQuery(`INSERT INTO audit_log \(method, path, body_hash\)\s+VALUES \(\$1, \$2, \$3\)\s+RETURNING id, created_at`).
+		WithArgs("POST", "/api/v1/features", "abc").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(9, now))
+	mock.ExpectExec(`UPDATE audit_log\s+SET actor_id = \$2, status = \$3, completed_at = CURRENT_TIMESTAMP\s+WHERE id = \$1`).
+		WithArgs(9, &actorID, 201).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	entry := &audit.Entry{Method: "POST", Path: "/api/v1/features", BodyHash: "abc"}
+	require.NoError(t, repo.Begin(entry))
+	assert.Equal(t, 9, entry.ID)
+	assert.Equal(t, now, entry.CreatedAt)
+
+	assert.NoError(t, repo.Complete(entry.ID, &actorID, 201))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
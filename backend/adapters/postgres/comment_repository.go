@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/comments"
+)
+
+// CommentRepository implements comments.Repository
+type CommentRepository struct {
+	db *DB
+}
+
+// NewCommentRepository creates a new comment repository
+func NewCommentRepository(db *DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// Create stores a comment and fills in its ID and creation time
+func (r *CommentRepository) Create(comment *comments.Comment) error {
+	if err := comments.ValidateBody(comment.Body); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO comments (feature_id, user_id, body)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRow(query, comment.FeatureID, comment.UserID, comment.Body).
+		Scan(&comment.ID, &comment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return nil
+}
+
+// GetByFeatureID returns a feature's comments, oldest first so the thread reads top to bottom
+func (r *CommentRepository) GetByFeatureID(featureID int) ([]comments.Comment, error) {
+	query := `
+		SELECT id, feature_id, user_id, body, created_at
+		FROM comments
+		WHERE feature_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.db.Query(query, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	defer rows.Close()
+
+	commentList := []comments.Comment{}
+	for rows.Next() {
+		var comment comments.Comment
+		err := rows.Scan(&comment.ID, &comment.FeatureID, &comment.UserID, &comment.Body, &comment.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		commentList = append(commentList, comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comments: %w", err)
+	}
+
+	return commentList, nil
+}
+
+// Delete removes a comment
+func (r *CommentRepository) Delete(id int) error {
+	result, err := r.db.Exec(`DELETE FROM comments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("comment not found")
+	}
+
+	return nil
+}
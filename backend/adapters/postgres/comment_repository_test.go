@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/feature-voting-platform/backend/domain/comments"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCommentRepository(&DB{db})
+	now := time.Now()
+
+	t.Run("stores the comment", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO comments \(feature_id, user_id, body\) VALUES \(\$1, \$2, \$3\) RETURNING id, created_at`).
+			WithArgs(1, 2, "Would this cover mobile too?").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, now))
+
+		comment := &comments.Comment{FeatureID: 1, UserID: 2, Body: "Would this cover mobile too?"}
+		err := repo.Create(comment)
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, comment.ID)
+		assert.Equal(t, now, comment.CreatedAt)
+	})
+
+	t.Run("body too long", func(t *testing.T) {
+		body := make([]rune, comments.MaxBodyLength+1)
+		for i := range body {
+			body[i] = 'c'
+		}
+
+		err := repo.Create(&comments.Comment{FeatureID: 1, UserID: 2, Body: string(body)})
+
+		assert.ErrorIs(t, err, comments.ErrBodyTooLong)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCommentRepository_GetByFeatureID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCommentRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT id, feature_id, user_id, body, created_at FROM comments WHERE feature_id = \$1 ORDER BY created_at ASC, id ASC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id", "user_id", "body", "created_at"}).
+			AddRow(1, 1, 2, "First", now.Add(-time.Hour)).
+			AddRow(2, 1, 3, "Second", now))
+
+	list, err := repo.GetByFeatureID(1)
+
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "First", list[0].Body)
+	assert.Equal(t, 3, list[1].UserID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCommentRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCommentRepository(&DB{db})
+
+	mock.ExpectExec(`DELETE FROM comments WHERE id = \$1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM comments WHERE id = \$1`).
+		WithArgs(99).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, repo.Delete(1))
+	assert.EqualError(t, repo.Delete(99), "comment not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
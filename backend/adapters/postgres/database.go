@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"time"
 
 	_ "github.com/lib/pq"
 )
@@ -13,12 +14,52 @@ type DB struct {
 	*sql.DB
 }
 
+// PoolConfig tunes the connection pool. Zero values keep database/sql's defaults
+// (unlimited open connections, 2 idle connections, no lifetime or idle time limit).
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	// ConnMaxIdleTime closes idle connections before cloud databases and proxies drop them
+	ConnMaxIdleTime time.Duration
+}
+
+// poolSettings is the part of *sql.DB that PoolConfig configures
+type poolSettings interface {
+	SetMaxOpenConns(n int)
+	SetMaxIdleConns(n int)
+	SetConnMaxLifetime(d time.Duration)
+	SetConnMaxIdleTime(d time.Duration)
+}
+
+// apply sets the non-zero pool limits on db
+func (p PoolConfig) apply(db poolSettings) {
+	if p.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(p.MaxOpenConns)
+	}
+	if p.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(p.MaxIdleConns)
+	}
+	if p.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(p.ConnMaxLifetime)
+	}
+	if p.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(p.ConnMaxIdleTime)
+	}
+}
+
 // NewDatabase creates a new database connection
 func NewDatabase(databaseURL string) (*DB, error) {
+	return NewDatabaseWithPool(databaseURL, PoolConfig{})
+}
+
+// NewDatabaseWithPool creates a new database connection with a tuned connection pool
+func NewDatabaseWithPool(databaseURL string, pool PoolConfig) (*DB, error) {
 	db, err := sql.Open("postgres", databaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	pool.apply(db)
 
 	if err := db.Ping(); err != nil {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
@@ -32,4 +73,4 @@ func NewDatabase(databaseURL string) (*DB, error) {
 // Close closes the database connection
 func (db *DB) Close() error {
 	return db.DB.Close()
-}
\ No newline at end of file
+}
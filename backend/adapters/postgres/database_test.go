@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedPool struct {
+	maxOpen     int
+	maxIdle     int
+	maxLifetime time.Duration
+	maxIdleTime time.Duration
+}
+
+func (p *recordedPool) SetMaxOpenConns(n int)              { p.maxOpen = n }
+func (p *recordedPool) SetMaxIdleConns(n int)              { p.maxIdle = n }
+func (p *recordedPool) SetConnMaxLifetime(d time.Duration) { p.maxLifetime = d }
+func (p *recordedPool) SetConnMaxIdleTime(d time.Duration) { p.maxIdleTime = d }
+
+func TestPoolConfig_Apply(t *testing.T) {
+	t.Run("configured values are applied", func(t *testing.T) {
+		pool := &recordedPool{}
+
+		PoolConfig{
+			MaxOpenConns:    25,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		}.apply(pool)
+
+		assert.Equal(t, &recordedPool{
+			maxOpen:     25,
+			maxIdle:     10,
+			maxLifetime: 30 * time.Minute,
+			maxIdleTime: 5 * time.Minute,
+		}, pool)
+	})
+
+	t.Run("zero values keep the defaults", func(t *testing.T) {
+		pool := &recordedPool{maxOpen: -1, maxIdle: -1, maxLifetime: -1, maxIdleTime: -1}
+
+		PoolConfig{}.apply(pool)
+
+		assert.Equal(t, &recordedPool{maxOpen: -1, maxIdle: -1, maxLifetime: -1, maxIdleTime: -1}, pool)
+	})
+
+	t.Run("applies to a real connection pool", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		PoolConfig{MaxOpenConns: 7}.apply(db)
+
+		assert.Equal(t, 7, db.Stats().MaxOpenConnections)
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/feature-voting-platform/backend/domain/features"
 	"github.com/feature-voting-platform/backend/domain/votes"
+	"github.com/lib/pq"
 )
 
 // FeatureRepository implements both features.Repository and votes.Repository interfaces
@@ -23,13 +25,17 @@ func NewFeatureRepository(db *DB) *FeatureRepository {
 
 // Create creates a new feature in the database
 func (r *FeatureRepository) Create(feature *features.Feature) error {
+	if err := features.ValidateLengths(feature.Title, feature.Description); err != nil {
+		return err
+	}
+
 	query := `
-		INSERT INTO features (title, description, created_by)
-		VALUES ($1, $2, $3)
+		INSERT INTO features (title, description, created_by, expires_at)
+		VALUES ($1, $2, $3, $4)
 		RETURNING id, vote_count, created_at, updated_at
 	`
 	
-	err := r.db.QueryRow(query, feature.Title, feature.Description, feature.CreatedBy).
+	err := r.db.QueryRow(query, feature.Title, feature.Description, feature.CreatedBy, feature.ExpiresAt).
 		Scan(&feature.ID, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt)
 	
 	if err != nil {
@@ -39,20 +45,47 @@ func (r *FeatureRepository) Create(feature *features.Feature) error {
 	return nil
 }
 
+// featureTagsColumn selects the tag names of feature f, alphabetically, as a text array
+const featureTagsColumn = `ARRAY(
+		       SELECT t.name FROM feature_tags ft JOIN tags t ON t.id = ft.tag_id
+		       WHERE ft.feature_id = f.id ORDER BY t.name
+		       ) AS tags`
+
+// votingOpenCondition matches features f still accepting votes: not marked expired, not past
+// their deadline and not completed or rejected
+const votingOpenCondition = `NOT f.expired AND (f.expires_at IS NULL OR f.expires_at > CURRENT_TIMESTAMP)
+		  AND f.status NOT IN ('completed', 'rejected')`
+
+// featureCreatedFilter bounds the creation time of feature f by the after and before params,
+// either of which may be NULL for no bound
+func featureCreatedFilter(after, before string) string {
+	return `(` + after + `::timestamp IS NULL OR f.created_at >= ` + after + `)
+		  AND (` + before + `::timestamp IS NULL OR f.created_at <= ` + before + `)`
+}
+
+// featureTagFilter matches features f tagged with the tag in param, or all features when it is empty
+func featureTagFilter(param string) string {
+	return `(` + param + ` = '' OR EXISTS(
+		SELECT 1 FROM feature_tags ft JOIN tags t ON t.id = ft.tag_id
+		WHERE ft.feature_id = f.id AND t.name = ` + param + `))`
+}
+
 // GetByID retrieves a feature by ID
 func (r *FeatureRepository) GetByID(id int, userID *int) (*features.Feature, error) {
 	feature := &features.Feature{}
 	query := `
 		SELECT f.id, f.title, f.description, f.created_by, u.username,
-		       f.vote_count, f.created_at, f.updated_at
+		       f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status,
+		       ` + featureTagsColumn + `
 		FROM features f
 		LEFT JOIN users u ON f.created_by = u.id
-		WHERE f.id = $1
+		WHERE f.id = $1 AND f.deleted_at IS NULL
 	`
 	
 	err := r.db.QueryRow(query, id).Scan(
 		&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
 		&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+		&feature.ExpiresAt, &feature.Expired, &feature.Status, pq.Array(&feature.Tags),
 	)
 	
 	if err != nil {
@@ -62,41 +95,107 @@ func (r *FeatureRepository) GetByID(id int, userID *int) (*features.Feature, err
 		return nil, fmt.Errorf("failed to get feature by ID: %w", err)
 	}
 	
-	// Check if user has voted for this feature
+	// Check if and which way the user has voted for this feature
 	if userID != nil {
-		hasVoted, err := r.HasUserVoted(*userID, id)
-		if err != nil {
+		vote, err := r.GetUserVote(*userID, id, votes.DefaultCategory)
+		if err != nil && err.Error() != "vote not found" {
 			return nil, fmt.Errorf("failed to check user vote status: %w", err)
 		}
-		feature.HasUserVoted = hasVoted
+		if vote != nil {
+			feature.HasUserVoted = true
+			feature.UserVote = vote.Value
+		}
 	}
 	
 	return feature, nil
 }
 
-// GetAll retrieves all features with pagination
-func (r *FeatureRepository) GetAll(page, perPage int, userID *int) ([]features.Feature, int, error) {
+// GetByIDs retrieves the features with the given IDs; missing IDs are simply absent from the result
+func (r *FeatureRepository) GetByIDs(ids []int, userID *int) ([]features.Feature, error) {
+	query := `
+		SELECT f.id, f.title, f.description, f.created_by, u.username,
+		       f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status,
+		       CASE WHEN $2::int IS NULL THEN false
+		            ELSE EXISTS(SELECT 1 FROM votes v WHERE v.feature_id = f.id AND v.user_id = $2)
+		       END as has_user_voted,
+		       ` + featureTagsColumn + `
+		FROM features f
+		LEFT JOIN users u ON f.created_by = u.id
+		WHERE f.id = ANY($1) AND f.deleted_at IS NULL
+	`
+
+	rows, err := r.db.Query(query, pq.Array(ids), userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get features by IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var featuresList []features.Feature
+	for rows.Next() {
+		var feature features.Feature
+		err := rows.Scan(
+			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
+			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.ExpiresAt, &feature.Expired, &feature.Status, &feature.HasUserVoted,
+			pq.Array(&feature.Tags),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		featuresList = append(featuresList, feature)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return featuresList, nil
+}
+
+// featureSortClauses whitelists the ORDER BY clause for each sort order; user input never reaches the SQL
+var featureSortClauses = map[features.SortOrder]string{
+	features.SortVotes:  "f.vote_count DESC, f.created_at DESC",
+	features.SortNewest: "f.created_at DESC, f.id DESC",
+	features.SortOldest: "f.created_at ASC, f.id ASC",
+}
+
+// GetAll retrieves all features with pagination; a non-empty status only returns features in that
+// status, and non-nil createdAfter and createdBefore bound their creation time, inclusively
+func (r *FeatureRepository) GetAll(page, perPage int, userID *int, sort features.SortOrder, status, tag string, createdAfter, createdBefore *time.Time) ([]features.Feature, int, error) {
+	orderBy, ok := featureSortClauses[sort]
+	if !ok {
+		orderBy = featureSortClauses[features.SortVotes]
+	}
+
 	offset := (page - 1) * perPage
 	
 	// Get total count
 	var total int
-	countQuery := `SELECT COUNT(*) FROM features`
-	err := r.db.QueryRow(countQuery).Scan(&total)
+	countQuery := `
+		SELECT COUNT(*) FROM features f
+		WHERE f.deleted_at IS NULL AND ($1 = '' OR f.status = $1) AND ` + featureTagFilter("$2") + `
+		  AND ` + featureCreatedFilter("$3", "$4")
+	err := r.db.QueryRow(countQuery, status, tag, createdAfter, createdBefore).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get features count: %w", err)
 	}
 	
-	// Get features with pagination, sorted by vote count (most voted first)
+	// Get features with pagination in the requested order
 	query := `
 		SELECT f.id, f.title, f.description, f.created_by, u.username,
-		       f.vote_count, f.created_at, f.updated_at
+		       f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status,
+		       CASE WHEN v.id IS NOT NULL THEN true ELSE false END as has_user_voted,
+		       ` + featureTagsColumn + `
 		FROM features f
 		LEFT JOIN users u ON f.created_by = u.id
-		ORDER BY f.vote_count DESC, f.created_at DESC
+		LEFT JOIN votes v ON v.feature_id = f.id AND v.user_id = $3 AND v.category = 'default'
+		WHERE f.deleted_at IS NULL AND ($4 = '' OR f.status = $4) AND ` + featureTagFilter("$5") + `
+		  AND ` + featureCreatedFilter("$6", "$7") + `
+		ORDER BY ` + orderBy + `
 		LIMIT $1 OFFSET $2
 	`
 	
-	rows, err := r.db.Query(query, perPage, offset)
+	rows, err := r.db.Query(query, perPage, offset, userID, status, tag, createdAfter, createdBefore)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get features: %w", err)
 	}
@@ -108,20 +207,13 @@ func (r *FeatureRepository) GetAll(page, perPage int, userID *int) ([]features.F
 		err := rows.Scan(
 			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
 			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.ExpiresAt, &feature.Expired, &feature.Status, &feature.HasUserVoted,
+			pq.Array(&feature.Tags),
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan feature: %w", err)
 		}
 		
-		// Check if user has voted for this feature
-		if userID != nil {
-			hasVoted, err := r.HasUserVoted(*userID, feature.ID)
-			if err != nil {
-				return nil, 0, fmt.Errorf("failed to check user vote status: %w", err)
-			}
-			feature.HasUserVoted = hasVoted
-		}
-		
 		featuresList = append(featuresList, feature)
 	}
 	
@@ -132,14 +224,74 @@ func (r *FeatureRepository) GetAll(page, perPage int, userID *int) ([]features.F
 	return featuresList, total, nil
 }
 
+// likeEscaper escapes LIKE wildcards so search terms match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchFeatures returns features whose title or description contains query, case-insensitively,
+// most voted first
+func (r *FeatureRepository) SearchFeatures(query string, page, perPage int, userID *int) ([]features.Feature, int, error) {
+	offset := (page - 1) * perPage
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM features f WHERE f.deleted_at IS NULL AND (f.title ILIKE $1 OR f.description ILIKE $1)`
+	err := r.db.QueryRow(countQuery, pattern).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get search results count: %w", err)
+	}
+
+	searchQuery := `
+		SELECT f.id, f.title, f.description, f.created_by, u.username,
+		       f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status,
+		       CASE WHEN v.id IS NOT NULL THEN true ELSE false END as has_user_voted,
+		       ` + featureTagsColumn + `
+		FROM features f
+		LEFT JOIN users u ON f.created_by = u.id
+		LEFT JOIN votes v ON v.feature_id = f.id AND v.user_id = $4 AND v.category = 'default'
+		WHERE f.deleted_at IS NULL AND (f.title ILIKE $1 OR f.description ILIKE $1)
+		ORDER BY f.vote_count DESC, f.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(searchQuery, pattern, perPage, offset, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search features: %w", err)
+	}
+	defer rows.Close()
+
+	var featuresList []features.Feature
+	for rows.Next() {
+		var feature features.Feature
+		err := rows.Scan(
+			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
+			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.ExpiresAt, &feature.Expired, &feature.Status, &feature.HasUserVoted,
+			pq.Array(&feature.Tags),
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		featuresList = append(featuresList, feature)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return featuresList, total, nil
+}
+
 // GetByCreatedBy retrieves features created by a specific user
 func (r *FeatureRepository) GetByCreatedBy(userID int) ([]features.Feature, error) {
 	query := `
 		SELECT f.id, f.title, f.description, f.created_by, u.username,
-		       f.vote_count, f.created_at, f.updated_at
+		       f.vote_count, f.created_at, f.updated_at, f.status,
+		       CASE WHEN v.id IS NOT NULL THEN true ELSE false END as has_user_voted,
+		       ` + featureTagsColumn + `
 		FROM features f
 		LEFT JOIN users u ON f.created_by = u.id
-		WHERE f.created_by = $1
+		LEFT JOIN votes v ON v.feature_id = f.id AND v.user_id = $1 AND v.category = 'default'
+		WHERE f.created_by = $1 AND f.deleted_at IS NULL
 		ORDER BY f.created_at DESC
 	`
 	
@@ -155,6 +307,7 @@ func (r *FeatureRepository) GetByCreatedBy(userID int) ([]features.Feature, erro
 		err := rows.Scan(
 			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
 			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.Status, &feature.HasUserVoted, pq.Array(&feature.Tags),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan feature: %w", err)
@@ -165,8 +318,322 @@ func (r *FeatureRepository) GetByCreatedBy(userID int) ([]features.Feature, erro
 	return featuresList, nil
 }
 
-// Update updates a feature
-func (r *FeatureRepository) Update(id int, title, description *string) error {
+// GetVotable retrieves features still open for voting that the user has not voted for yet,
+// with pagination
+func (r *FeatureRepository) GetVotable(userID, page, perPage int) ([]features.Feature, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `
+		SELECT COUNT(*) FROM features f
+		WHERE f.deleted_at IS NULL AND ` + votingOpenCondition + `
+		  AND NOT EXISTS (SELECT 1 FROM votes v WHERE v.feature_id = f.id AND v.user_id = $1)
+	`
+	err := r.db.QueryRow(countQuery, userID).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get votable features count: %w", err)
+	}
+
+	query := `
+		SELECT f.id, f.title, f.description, f.created_by, u.username,
+		       f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status,
+		       ` + featureTagsColumn + `
+		FROM features f
+		LEFT JOIN users u ON f.created_by = u.id
+		WHERE f.deleted_at IS NULL AND ` + votingOpenCondition + `
+		  AND NOT EXISTS (SELECT 1 FROM votes v WHERE v.feature_id = f.id AND v.user_id = $1)
+		ORDER BY f.vote_count DESC, f.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(query, userID, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get votable features: %w", err)
+	}
+	defer rows.Close()
+
+	var featuresList []features.Feature
+	for rows.Next() {
+		var feature features.Feature
+		err := rows.Scan(
+			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
+			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.ExpiresAt, &feature.Expired, &feature.Status, pq.Array(&feature.Tags),
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		featuresList = append(featuresList, feature)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return featuresList, total, nil
+}
+
+// windowedVoteCountQuery ranks features by the net score of votes cast at or after $1,
+// leaving out features whose window score is not positive
+const windowedVoteCountQuery = `
+	SELECT f.id, f.title, f.description, f.created_by, u.username,
+	       f.vote_count, f.created_at, f.updated_at, SUM(v.value) as window_votes
+	FROM features f
+	LEFT JOIN users u ON f.created_by = u.id
+	JOIN votes v ON v.feature_id = f.id AND v.created_at >= $1
+	WHERE f.deleted_at IS NULL
+	GROUP BY f.id, u.username
+	HAVING SUM(v.value) > 0
+	ORDER BY window_votes DESC, f.vote_count DESC, f.created_at DESC
+	LIMIT $2
+`
+
+// GetTop returns the most-voted features; votes are counted from since onwards, or all-time when since is nil
+func (r *FeatureRepository) GetTop(since *time.Time, limit int) ([]features.RankedFeature, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if since != nil {
+		rows, err = r.db.Query(windowedVoteCountQuery, *since, limit)
+	} else {
+		rows, err = r.db.Query(`
+			SELECT f.id, f.title, f.description, f.created_by, u.username,
+			       f.vote_count, f.created_at, f.updated_at, f.vote_count as window_votes
+			FROM features f
+			LEFT JOIN users u ON f.created_by = u.id
+			WHERE f.vote_count > 0 AND f.deleted_at IS NULL
+			ORDER BY f.vote_count DESC, f.created_at DESC
+			LIMIT $1
+		`, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top features: %w", err)
+	}
+	defer rows.Close()
+
+	var ranked []features.RankedFeature
+	for rows.Next() {
+		var rf features.RankedFeature
+		err := rows.Scan(
+			&rf.ID, &rf.Title, &rf.Description, &rf.CreatedBy,
+			&rf.CreatedByUser, &rf.VoteCount, &rf.CreatedAt, &rf.UpdatedAt,
+			&rf.WindowVoteCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		ranked = append(ranked, rf)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return ranked, nil
+}
+
+// GetCategoryCounts returns how many votes a feature received in each category; categories
+// without votes are absent
+func (r *FeatureRepository) GetCategoryCounts(featureID int) (map[string]int, error) {
+	rows, err := r.db.Query(`
+		SELECT category, COUNT(*)
+		FROM votes
+		WHERE feature_id = $1
+		GROUP BY category
+	`, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote category counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var category string
+		var count int
+		if err := rows.Scan(&category, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan vote category count: %w", err)
+		}
+		counts[category] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vote category counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// surgingFeaturesQuery compares each feature's net score in the last 24 hours ($1 times) against
+// its average daily net score before that; features younger than a day use a one-day baseline
+const surgingFeaturesQuery = `
+	WITH windowed AS (
+		SELECT f.id,
+		       COALESCE(SUM(v.value) FILTER (WHERE v.created_at >= NOW() - INTERVAL '24 hours'), 0) AS recent_votes,
+		       COALESCE(SUM(v.value) FILTER (WHERE v.created_at < NOW() - INTERVAL '24 hours'), 0)::float
+		           / GREATEST(EXTRACT(EPOCH FROM (NOW() - INTERVAL '24 hours' - f.created_at)) / 86400, 1) AS baseline_daily_votes
+		FROM features f
+		LEFT JOIN votes v ON v.feature_id = f.id
+		WHERE f.deleted_at IS NULL
+		GROUP BY f.id
+	)
+	SELECT f.id, f.title, f.description, f.created_by, u.username,
+	       f.vote_count, f.created_at, f.updated_at,
+	       w.recent_votes, w.baseline_daily_votes
+	FROM windowed w
+	JOIN features f ON f.id = w.id
+	LEFT JOIN users u ON f.created_by = u.id
+	WHERE w.recent_votes > 0 AND w.recent_votes > $1 * w.baseline_daily_votes
+	ORDER BY w.recent_votes DESC, f.created_at DESC
+	LIMIT $2
+`
+
+// GetSurgingFeatures returns the features voted more than multiplier times their prior daily
+// average in the last 24 hours, most recent votes first
+func (r *FeatureRepository) GetSurgingFeatures(multiplier float64, limit int) ([]features.SurgingFeature, error) {
+	rows, err := r.db.Query(surgingFeaturesQuery, multiplier, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get surging features: %w", err)
+	}
+	defer rows.Close()
+
+	var surging []features.SurgingFeature
+	for rows.Next() {
+		var sf features.SurgingFeature
+		err := rows.Scan(
+			&sf.ID, &sf.Title, &sf.Description, &sf.CreatedBy,
+			&sf.CreatedByUser, &sf.VoteCount, &sf.CreatedAt, &sf.UpdatedAt,
+			&sf.RecentVoteCount, &sf.BaselineDailyVotes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		surging = append(surging, sf)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return surging, nil
+}
+
+// teammateVoteCountQuery ranks features by upvotes from users sharing team $1, excluding the viewer $2
+const teammateVoteCountQuery = `
+	SELECT f.id, f.title, f.description, f.created_by, u.username,
+	       f.vote_count, f.created_at, f.updated_at,
+	       EXISTS(SELECT 1 FROM votes uv WHERE uv.feature_id = f.id AND uv.user_id = $2) as has_user_voted,
+	       COUNT(v.id) as teammate_votes
+	FROM features f
+	LEFT JOIN users u ON f.created_by = u.id
+	JOIN votes v ON v.feature_id = f.id AND v.value = 1
+	JOIN users tm ON tm.id = v.user_id AND tm.team_id = $1 AND tm.id <> $2
+	WHERE f.deleted_at IS NULL
+	GROUP BY f.id, u.username
+	ORDER BY teammate_votes DESC, f.vote_count DESC, f.created_at DESC
+	LIMIT $3
+`
+
+// GetTeamPicks returns the features most voted by the user's teammates.
+// Returns a "user has no team" error when the user is not on a team.
+func (r *FeatureRepository) GetTeamPicks(userID, limit int) ([]features.TeamPick, error) {
+	var teamID sql.NullInt64
+	err := r.db.QueryRow(`SELECT team_id FROM users WHERE id = $1`, userID).Scan(&teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user team: %w", err)
+	}
+	if !teamID.Valid {
+		return nil, fmt.Errorf("user has no team")
+	}
+
+	rows, err := r.db.Query(teammateVoteCountQuery, teamID.Int64, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team picks: %w", err)
+	}
+	defer rows.Close()
+
+	var picks []features.TeamPick
+	for rows.Next() {
+		var p features.TeamPick
+		err := rows.Scan(
+			&p.ID, &p.Title, &p.Description, &p.CreatedBy,
+			&p.CreatedByUser, &p.VoteCount, &p.CreatedAt, &p.UpdatedAt,
+			&p.HasUserVoted, &p.TeammateVoteCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		picks = append(picks, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return picks, nil
+}
+
+// coVotedFeaturesQuery ranks the other features upvoted by the users who upvoted feature $1;
+// a user voting in several categories counts once
+const coVotedFeaturesQuery = `
+	SELECT f.id, f.title, f.description, f.created_by, u.username,
+	       f.vote_count, f.created_at, f.updated_at,
+	       COUNT(DISTINCT other.user_id) as co_voters
+	FROM votes src
+	JOIN votes other ON other.user_id = src.user_id AND other.feature_id <> src.feature_id AND other.value = 1
+	JOIN features f ON f.id = other.feature_id
+	LEFT JOIN users u ON f.created_by = u.id
+	WHERE src.feature_id = $1 AND src.value = 1 AND f.deleted_at IS NULL
+	GROUP BY f.id, u.username
+	ORDER BY co_voters DESC, f.vote_count DESC, f.created_at DESC
+	LIMIT $2
+`
+
+// GetCoVotedFeatures returns the other features most often voted for by the feature's voters
+func (r *FeatureRepository) GetCoVotedFeatures(featureID int, limit int) ([]features.CoVotedFeature, error) {
+	rows, err := r.db.Query(coVotedFeaturesQuery, featureID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get co-voted features: %w", err)
+	}
+	defer rows.Close()
+
+	var coVoted []features.CoVotedFeature
+	for rows.Next() {
+		var f features.CoVotedFeature
+		err := rows.Scan(
+			&f.ID, &f.Title, &f.Description, &f.CreatedBy,
+			&f.CreatedByUser, &f.VoteCount, &f.CreatedAt, &f.UpdatedAt,
+			&f.CoVoterCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		coVoted = append(coVoted, f)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return coVoted, nil
+}
+
+// Update updates a feature; a new expiry reopens voting on an expired feature
+func (r *FeatureRepository) Update(id int, title, description *string, expiresAt *time.Time) error {
+	if title != nil {
+		if err := features.ValidateLengths(*title, ""); err != nil {
+			return err
+		}
+	}
+	if description != nil {
+		if err := features.ValidateLengths("", *description); err != nil {
+			return err
+		}
+	}
+
 	setParts := []string{}
 	args := []interface{}{}
 	argCount := 1
@@ -182,10 +649,19 @@ func (r *FeatureRepository) Update(id int, title, description *string) error {
 		args = append(args, *description)
 		argCount++
 	}
+
+	if expiresAt != nil {
+		setParts = append(setParts, fmt.Sprintf("expires_at = $%d, expired = FALSE", argCount))
+		args = append(args, *expiresAt)
+		argCount++
+	}
 	
 	if len(setParts) == 0 {
 		return fmt.Errorf("no fields to update")
 	}
+
+	// Keep updated_at accurate; the minimum edit interval is measured from it
+	setParts = append(setParts, "updated_at = CURRENT_TIMESTAMP")
 	
 	query := fmt.Sprintf("UPDATE features SET %s WHERE id = $%d", 
 		strings.Join(setParts, ", "), argCount)
@@ -208,42 +684,156 @@ func (r *FeatureRepository) Update(id int, title, description *string) error {
 	return nil
 }
 
-// Delete deletes a feature
-func (r *FeatureRepository) Delete(id int) error {
-	tx, err := r.db.Begin()
+// UpdateStatus moves a feature to a new lifecycle status. updated_at is left alone so a status
+// change does not restart the creator's minimum edit interval.
+func (r *FeatureRepository) UpdateStatus(id int, status string) error {
+	result, err := r.db.Exec(`UPDATE features SET status = $1 WHERE id = $2`, status, id)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return fmt.Errorf("failed to update feature status: %w", err)
 	}
-	defer tx.Rollback()
-	
-	// Delete votes first
-	_, err = tx.Exec(`DELETE FROM votes WHERE feature_id = $1`, id)
+
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to delete votes: %w", err)
+		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
-	
-	// Delete feature
-	result, err := tx.Exec(`DELETE FROM features WHERE id = $1`, id)
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("feature not found")
+	}
+
+	return nil
+}
+
+// Delete soft-deletes a feature by setting deleted_at; it returns "feature not found" when it is missing or already deleted
+func (r *FeatureRepository) Delete(id int) error {
+	// Soft delete: votes and comments stay in place so Restore brings the feature back intact
+	result, err := r.db.Exec(`UPDATE features SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete feature: %w", err)
 	}
-	
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
-	
+
 	if rowsAffected == 0 {
 		return fmt.Errorf("feature not found")
 	}
-	
-	return tx.Commit()
-}
+
+	return nil
+}
+
+// Restore undoes a soft delete; it returns "feature not found" when no deleted feature has the ID
+func (r *FeatureRepository) Restore(id int) error {
+	result, err := r.db.Exec(`UPDATE features SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore feature: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("feature not found")
+	}
+
+	return nil
+}
+
+// AddTag tags a feature, creating the tag on first use; adding a tag the feature already has
+// is a no-op
+func (r *FeatureRepository) AddTag(featureID int, tag string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The no-op update makes RETURNING yield the ID of an existing tag too
+	var tagID int
+	err = tx.QueryRow(`
+		INSERT INTO tags (name) VALUES ($1)
+		ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`, tag).Scan(&tagID)
+	if err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO feature_tags (feature_id, tag_id) VALUES ($1, $2)
+		ON CONFLICT (feature_id, tag_id) DO NOTHING
+	`, featureID, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to tag feature: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// RemoveTag removes a tag from a feature
+func (r *FeatureRepository) RemoveTag(featureID int, tag string) error {
+	query := `
+		DELETE FROM feature_tags
+		WHERE feature_id = $1 AND tag_id = (SELECT id FROM tags WHERE name = $2)
+	`
+
+	result, err := r.db.Exec(query, featureID, tag)
+	if err != nil {
+		return fmt.Errorf("failed to remove tag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag not found")
+	}
+
+	return nil
+}
+
+// GetTags returns a feature's tag names in alphabetical order
+func (r *FeatureRepository) GetTags(featureID int) ([]string, error) {
+	query := `
+		SELECT t.name
+		FROM feature_tags ft
+		JOIN tags t ON t.id = ft.tag_id
+		WHERE ft.feature_id = $1
+		ORDER BY t.name
+	`
+
+	rows, err := r.db.Query(query, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tags: %w", err)
+	}
+
+	return tags, nil
+}
 
 // FeatureExists checks if a feature exists
 func (r *FeatureRepository) FeatureExists(id int) (bool, error) {
 	var exists bool
-	query := `SELECT EXISTS(SELECT 1 FROM features WHERE id = $1)`
+	query := `SELECT EXISTS(SELECT 1 FROM features WHERE id = $1 AND deleted_at IS NULL)`
 	
 	err := r.db.QueryRow(query, id).Scan(&exists)
 	if err != nil {
@@ -253,42 +843,331 @@ func (r *FeatureRepository) FeatureExists(id int) (bool, error) {
 	return exists, nil
 }
 
+// CountByCreator returns the number of features created by a user
+func (r *FeatureRepository) CountByCreator(userID int) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM features WHERE created_by = $1 AND deleted_at IS NULL`
+
+	err := r.db.QueryRow(query, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count features by creator: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountVotesReceived returns the total number of votes on features created by a user
+func (r *FeatureRepository) CountVotesReceived(userID int) (int, error) {
+	var count int
+	query := `SELECT COALESCE(SUM(vote_count), 0) FROM features WHERE created_by = $1 AND deleted_at IS NULL`
+
+	err := r.db.QueryRow(query, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count votes received: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetUserStats returns aggregate statistics for the features created by a user.
+// A user without features gets zeroed stats and no most-voted title.
+func (r *FeatureRepository) GetUserStats(userID int) (features.UserStats, error) {
+	stats := features.UserStats{UserID: userID}
+
+	totalsQuery := `
+		SELECT COUNT(*), COALESCE(SUM(vote_count), 0)
+		FROM features
+		WHERE created_by = $1 AND deleted_at IS NULL
+		GROUP BY created_by
+	`
+
+	err := r.db.QueryRow(totalsQuery, userID).Scan(&stats.FeatureCount, &stats.TotalVotesReceived)
+	if err == sql.ErrNoRows {
+		return stats, nil
+	}
+	if err != nil {
+		return features.UserStats{}, fmt.Errorf("failed to get user feature totals: %w", err)
+	}
+
+	if stats.FeatureCount > 0 {
+		stats.AverageVotesPerFeature = float64(stats.TotalVotesReceived) / float64(stats.FeatureCount)
+	}
+
+	topQuery := `
+		SELECT title
+		FROM features
+		WHERE created_by = $1 AND deleted_at IS NULL
+		ORDER BY vote_count DESC, created_at ASC, id ASC
+		LIMIT 1
+	`
+
+	var title string
+	err = r.db.QueryRow(topQuery, userID).Scan(&title)
+	if err != nil && err != sql.ErrNoRows {
+		return features.UserStats{}, fmt.Errorf("failed to get most voted feature: %w", err)
+	}
+	if err == nil {
+		stats.MostVotedFeatureTitle = &title
+	}
+
+	return stats, nil
+}
+
+// GetChangesSince counts features created by others and votes cast by others on the user's
+// features after since
+func (r *FeatureRepository) GetChangesSince(userID int, since time.Time) (features.ChangeSummary, error) {
+	summary := features.ChangeSummary{Since: &since}
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM features WHERE created_at > $2 AND created_by <> $1 AND deleted_at IS NULL),
+			(SELECT COUNT(*) FROM votes v
+			 JOIN features f ON f.id = v.feature_id
+			 WHERE f.created_by = $1 AND v.user_id <> $1 AND v.created_at > $2 AND f.deleted_at IS NULL)
+	`
+
+	err := r.db.QueryRow(query, userID, since).Scan(&summary.NewFeatures, &summary.NewVotesOnMyFeatures)
+	if err != nil {
+		return features.ChangeSummary{}, fmt.Errorf("failed to count changes: %w", err)
+	}
+
+	return summary, nil
+}
+
+// ExpireFeatures marks features whose voting deadline is at or before now as expired
+// and returns how many were flipped
+func (r *FeatureRepository) ExpireFeatures(now time.Time) (int, error) {
+	query := `
+		UPDATE features
+		SET expired = TRUE
+		WHERE expired = FALSE AND expires_at IS NOT NULL AND expires_at <= $1
+	`
+
+	result, err := r.db.Exec(query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire features: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// SnapshotVoteCounts records today's vote count for every undeleted feature, overwriting an earlier
+// snapshot from the same day, and returns how many features were recorded
+func (r *FeatureRepository) SnapshotVoteCounts() (int, error) {
+	query := `
+		INSERT INTO feature_vote_snapshots (snapshot_date, feature_id, vote_count)
+		SELECT CURRENT_DATE, id, vote_count FROM features WHERE deleted_at IS NULL
+		ON CONFLICT (snapshot_date, feature_id) DO UPDATE SET vote_count = EXCLUDED.vote_count
+	`
+
+	result, err := r.db.Exec(query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to snapshot vote counts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// RecalculateVoteCounts resets every feature's vote count to the sum of its vote values,
+// healing drift in the denormalized counter, and returns how many features were corrected
+func (r *FeatureRepository) RecalculateVoteCounts() (int, error) {
+	query := `
+		UPDATE features f
+		SET vote_count = actual.total
+		FROM (
+			SELECT f2.id, COALESCE(SUM(v.value), 0) AS total
+			FROM features f2
+			LEFT JOIN votes v ON v.feature_id = f2.id
+			GROUP BY f2.id
+		) actual
+		WHERE f.id = actual.id AND f.vote_count <> actual.total
+	`
+
+	result, err := r.db.Exec(query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to recalculate vote counts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// GetRankHistory returns the feature's daily rank over the last days days, computed from the
+// vote count snapshots of every feature on each of those days
+func (r *FeatureRepository) GetRankHistory(featureID int, days int) ([]features.RankPoint, error) {
+	query := `
+		SELECT snapshot_date, feature_id, vote_count
+		FROM feature_vote_snapshots
+		WHERE snapshot_date > CURRENT_DATE - $1::int
+		  AND snapshot_date IN (SELECT snapshot_date FROM feature_vote_snapshots WHERE feature_id = $2)
+		ORDER BY snapshot_date
+	`
+
+	rows, err := r.db.Query(query, days, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []features.VoteSnapshot
+	for rows.Next() {
+		var snapshot features.VoteSnapshot
+		if err := rows.Scan(&snapshot.Date, &snapshot.FeatureID, &snapshot.VoteCount); err != nil {
+			return nil, fmt.Errorf("failed to scan vote snapshot: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vote snapshots: %w", err)
+	}
+
+	return features.RankHistory(featureID, snapshots), nil
+}
+
 // Vote-related methods implementing votes.Repository
 
-// AddVote adds a vote for a feature
-func (r *FeatureRepository) AddVote(userID, featureID int) error {
+// AddVote adds an upvote or downvote in the given category for a feature with an optional reason;
+// the feature's vote count moves by value. Adding a vote that already exists is a no-op, reported
+// by returning false
+func (r *FeatureRepository) AddVote(userID, featureID int, category string, value int, reason *string) (bool, error) {
 	// Begin transaction with SERIALIZABLE isolation level
 	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 	
 	// Set transaction isolation level to SERIALIZABLE
 	_, err = tx.Exec("SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
 	if err != nil {
-		return fmt.Errorf("failed to set isolation level: %w", err)
+		return false, fmt.Errorf("failed to set isolation level: %w", err)
 	}
 	
-	// Insert vote
-	query := `INSERT INTO votes (user_id, feature_id) VALUES ($1, $2)`
-	_, err = tx.Exec(query, userID, featureID)
+	if err := checkVotingOpen(tx, featureID); err != nil {
+		return false, err
+	}
+
+	// Insert vote; a concurrent or retried request for the same vote inserts nothing
+	query := `
+		INSERT INTO votes (user_id, feature_id, category, value, reason) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, feature_id, category) DO NOTHING
+	`
+	result, err := tx.Exec(query, userID, featureID, category, value, reason)
 	if err != nil {
-		return fmt.Errorf("failed to add vote: %w", err)
+		return false, fmt.Errorf("failed to add vote: %w", err)
 	}
-	
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if inserted == 0 {
+		// The vote already exists, so the count and last action must stay as they are
+		return false, nil
+	}
+
 	// Update feature vote count
-	updateQuery := `UPDATE features SET vote_count = vote_count + 1 WHERE id = $1`
-	_, err = tx.Exec(updateQuery, featureID)
+	updateQuery := `UPDATE features SET vote_count = vote_count + $2 WHERE id = $1`
+	_, err = tx.Exec(updateQuery, featureID, value)
 	if err != nil {
-		return fmt.Errorf("failed to update vote count: %w", err)
+		return false, fmt.Errorf("failed to update vote count: %w", err)
 	}
-	
-	return tx.Commit()
+
+	if err := recordVoteAction(tx, userID, featureID, category, votes.ActionAdd); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// ChangeVoteDirection turns the user's vote in the given category to value, moving the feature's
+// vote count by twice value. It returns false when there is no vote in the other direction to change
+func (r *FeatureRepository) ChangeVoteDirection(userID, featureID int, category string, value int) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+	if err != nil {
+		return false, fmt.Errorf("failed to set isolation level: %w", err)
+	}
+
+	if err := checkVotingOpen(tx, featureID); err != nil {
+		return false, err
+	}
+
+	result, err := tx.Exec(
+		`UPDATE votes SET value = $4 WHERE user_id = $1 AND feature_id = $2 AND category = $3 AND value <> $4`,
+		userID, featureID, category, value,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to change vote direction: %w", err)
+	}
+
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if changed == 0 {
+		return false, nil
+	}
+
+	// The old value comes out of the count and the new one goes in
+	_, err = tx.Exec(`UPDATE features SET vote_count = vote_count + $2 WHERE id = $1`, featureID, 2*value)
+	if err != nil {
+		return false, fmt.Errorf("failed to update vote count: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
 }
 
-// RemoveVote removes a vote from a feature
-func (r *FeatureRepository) RemoveVote(userID, featureID int) error {
+// checkVotingOpen fails with ErrVotingClosed once the feature's deadline has passed, even before
+// the expiry job marks it, and for completed or rejected features
+func checkVotingOpen(tx *sql.Tx, featureID int) error {
+	var closed bool
+	query := `
+		SELECT expired OR (expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP)
+		       OR status IN ('completed', 'rejected')
+		FROM features
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	err := tx.QueryRow(query, featureID).Scan(&closed)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("feature not found")
+		}
+		return fmt.Errorf("failed to check voting deadline: %w", err)
+	}
+	if closed {
+		return votes.ErrVotingClosed
+	}
+	return nil
+}
+
+// RemoveVote removes the user's vote in the given category from a feature
+func (r *FeatureRepository) RemoveVote(userID, featureID int, category string) error {
 	// Begin transaction with SERIALIZABLE isolation level
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -303,48 +1182,222 @@ func (r *FeatureRepository) RemoveVote(userID, featureID int) error {
 	}
 	
 	// Delete vote
-	query := `DELETE FROM votes WHERE user_id = $1 AND feature_id = $2`
-	result, err := tx.Exec(query, userID, featureID)
+	var value int
+	query := `DELETE FROM votes WHERE user_id = $1 AND feature_id = $2 AND category = $3 RETURNING value`
+	err = tx.QueryRow(query, userID, featureID, category).Scan(&value)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("vote not found")
+		}
 		return fmt.Errorf("failed to remove vote: %w", err)
 	}
 	
-	rowsAffected, err := result.RowsAffected()
+	// Take the vote's value back out of the feature vote count
+	updateQuery := `UPDATE features SET vote_count = vote_count - $2 WHERE id = $1`
+	_, err = tx.Exec(updateQuery, featureID, value)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to update vote count: %w", err)
 	}
-	
-	if rowsAffected == 0 {
-		return fmt.Errorf("vote not found")
+
+	if err := recordVoteAction(tx, userID, featureID, category, votes.ActionRemove); err != nil {
+		return err
 	}
 	
-	// Update feature vote count (decrement)
-	updateQuery := `UPDATE features SET vote_count = vote_count - 1 WHERE id = $1`
-	_, err = tx.Exec(updateQuery, featureID)
+	return tx.Commit()
+}
+
+// RemoveVotes removes a user's votes in every category from the given features in one
+// transaction and returns how many were removed; features the user has not voted for are ignored
+func (r *FeatureRepository) RemoveVotes(userID int, featureIDs []int) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+	if err != nil {
+		return 0, fmt.Errorf("failed to set isolation level: %w", err)
+	}
+
+	rows, err := tx.Query(
+		`DELETE FROM votes WHERE user_id = $1 AND feature_id = ANY($2) RETURNING feature_id, category, value`,
+		userID, pq.Array(featureIDs),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove votes: %w", err)
+	}
+
+	var (
+		removed, values []int
+		lastCategory    string
+	)
+	for rows.Next() {
+		var featureID, value int
+		if err := rows.Scan(&featureID, &lastCategory, &value); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan removed vote: %w", err)
+		}
+		removed = append(removed, featureID)
+		values = append(values, value)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating removed votes: %w", err)
+	}
+
+	if len(removed) == 0 {
+		return 0, tx.Commit()
+	}
+
+	// A feature appears once per removed category vote, so its count drops by the sum of their values
+	_, err = tx.Exec(`
+		UPDATE features SET vote_count = vote_count - (SELECT SUM(r.value) FROM unnest($1::int[], $2::int[]) AS r(id, value) WHERE r.id = features.id)
+		WHERE id = ANY($1)
+	`, pq.Array(removed), pq.Array(values))
+	if err != nil {
+		return 0, fmt.Errorf("failed to update vote counts: %w", err)
+	}
+
+	if err := recordVoteAction(tx, userID, removed[len(removed)-1], lastCategory, votes.ActionRemove); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(removed), nil
+}
+
+// recordVoteAction remembers the user's latest vote change so it can be undone
+func recordVoteAction(tx *sql.Tx, userID, featureID int, category, action string) error {
+	query := `
+		INSERT INTO last_vote_actions (user_id, feature_id, category, action, created_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+		ON CONFLICT (user_id) DO UPDATE
+		SET feature_id = EXCLUDED.feature_id, category = EXCLUDED.category, action = EXCLUDED.action, created_at = EXCLUDED.created_at
+	`
+	if _, err := tx.Exec(query, userID, featureID, category, action); err != nil {
+		return fmt.Errorf("failed to record vote action: %w", err)
+	}
+	return nil
+}
+
+// GetLastVoteAction returns the user's most recent vote change
+func (r *FeatureRepository) GetLastVoteAction(userID int) (*votes.VoteAction, error) {
+	query := `
+		SELECT user_id, feature_id, category, action, created_at
+		FROM last_vote_actions
+		WHERE user_id = $1
+	`
+
+	var action votes.VoteAction
+	err := r.db.QueryRow(query, userID).Scan(&action.UserID, &action.FeatureID, &action.Category, &action.Action, &action.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("vote action not found")
+		}
+		return nil, fmt.Errorf("failed to get last vote action: %w", err)
+	}
+
+	return &action, nil
+}
+
+// UndoVote removes the user's vote in the given category from a feature and clears their last
+// vote action in one transaction, so the same action cannot be undone twice
+func (r *FeatureRepository) UndoVote(userID, featureID int, category string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+	if err != nil {
+		return fmt.Errorf("failed to set isolation level: %w", err)
+	}
+
+	var value int
+	err = tx.QueryRow(`DELETE FROM votes WHERE user_id = $1 AND feature_id = $2 AND category = $3 RETURNING value`, userID, featureID, category).Scan(&value)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("vote not found")
+		}
+		return fmt.Errorf("failed to remove vote: %w", err)
+	}
+
+	_, err = tx.Exec(`UPDATE features SET vote_count = vote_count - $2 WHERE id = $1`, featureID, value)
 	if err != nil {
 		return fmt.Errorf("failed to update vote count: %w", err)
 	}
-	
+
+	_, err = tx.Exec(`DELETE FROM last_vote_actions WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear vote action: %w", err)
+	}
+
 	return tx.Commit()
 }
 
-// HasUserVoted checks if a user has voted for a feature
-func (r *FeatureRepository) HasUserVoted(userID, featureID int) (bool, error) {
-	var exists bool
-	query := `SELECT EXISTS(SELECT 1 FROM votes WHERE user_id = $1 AND feature_id = $2)`
-	
-	err := r.db.QueryRow(query, userID, featureID).Scan(&exists)
+// GetUserVote returns the user's vote for a feature in the given category, including its
+// direction; it returns "vote not found" when the user has not voted
+func (r *FeatureRepository) GetUserVote(userID, featureID int, category string) (*votes.Vote, error) {
+	query := `
+		SELECT id, user_id, feature_id, category, value, reason, created_at
+		FROM votes
+		WHERE user_id = $1 AND feature_id = $2 AND category = $3
+	`
+
+	var vote votes.Vote
+	err := r.db.QueryRow(query, userID, featureID, category).Scan(
+		&vote.ID, &vote.UserID, &vote.FeatureID, &vote.Category, &vote.Value, &vote.Reason, &vote.CreatedAt,
+	)
 	if err != nil {
-		return false, fmt.Errorf("failed to check user vote: %w", err)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("vote not found")
+		}
+		return nil, fmt.Errorf("failed to get user vote: %w", err)
 	}
-	
-	return exists, nil
+
+	return &vote, nil
+}
+
+// HasUserVotedBulk reports for each of the given features whether the user has voted on it in
+// any category; every requested ID is present in the result
+func (r *FeatureRepository) HasUserVotedBulk(userID int, featureIDs []int) (map[int]bool, error) {
+	voted := make(map[int]bool, len(featureIDs))
+	for _, id := range featureIDs {
+		voted[id] = false
+	}
+
+	rows, err := r.db.Query(
+		`SELECT DISTINCT feature_id FROM votes WHERE user_id = $1 AND feature_id = ANY($2)`,
+		userID, pq.Array(featureIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user vote status: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var featureID int
+		if err := rows.Scan(&featureID); err != nil {
+			return nil, fmt.Errorf("failed to scan vote status: %w", err)
+		}
+		voted[featureID] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vote status: %w", err)
+	}
+
+	return voted, nil
 }
 
 // GetUserVotes retrieves all votes made by a user
 func (r *FeatureRepository) GetUserVotes(userID int) ([]votes.Vote, error) {
 	query := `
-		SELECT v.id, v.user_id, v.feature_id, v.created_at
+		SELECT v.id, v.user_id, v.feature_id, v.category, v.value, v.reason, v.created_at
 		FROM votes v
 		WHERE v.user_id = $1
 		ORDER BY v.created_at DESC
@@ -360,7 +1413,7 @@ func (r *FeatureRepository) GetUserVotes(userID int) ([]votes.Vote, error) {
 	for rows.Next() {
 		var vote votes.Vote
 		err := rows.Scan(
-			&vote.ID, &vote.UserID, &vote.FeatureID, &vote.CreatedAt,
+			&vote.ID, &vote.UserID, &vote.FeatureID, &vote.Category, &vote.Value, &vote.Reason, &vote.CreatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan vote: %w", err)
@@ -369,4 +1422,326 @@ func (r *FeatureRepository) GetUserVotes(userID int) ([]votes.Vote, error) {
 	}
 	
 	return votesList, nil
-}
\ No newline at end of file
+}
+
+// GetUserVotesWithFeatures retrieves a user's votes, newest first, each with the title, current
+// vote count and creation time of its feature; votes on deleted features are left out
+func (r *FeatureRepository) GetUserVotesWithFeatures(userID int) ([]votes.VoteWithFeature, error) {
+	query := `
+		SELECT v.id, v.user_id, v.feature_id, v.category, v.value, v.reason, v.created_at,
+		       f.title, f.vote_count, f.created_at
+		FROM votes v
+		JOIN features f ON f.id = v.feature_id
+		WHERE v.user_id = $1 AND f.deleted_at IS NULL
+		ORDER BY v.created_at DESC
+	`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user votes with features: %w", err)
+	}
+	defer rows.Close()
+
+	var votesList []votes.VoteWithFeature
+	for rows.Next() {
+		var vote votes.VoteWithFeature
+		err := rows.Scan(
+			&vote.ID, &vote.UserID, &vote.FeatureID, &vote.Category, &vote.Value, &vote.Reason, &vote.CreatedAt,
+			&vote.Feature.Title, &vote.Feature.VoteCount, &vote.Feature.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan vote: %w", err)
+		}
+		vote.Feature.ID = vote.FeatureID
+		votesList = append(votesList, vote)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating votes: %w", err)
+	}
+
+	return votesList, nil
+}
+
+// GetVotersByFeature returns up to limit of a feature's votes with their voters' usernames,
+// most recent first
+func (r *FeatureRepository) GetVotersByFeature(featureID int, limit int) ([]votes.VoterInfo, error) {
+	query := `
+		SELECT v.user_id, u.username, v.category, v.value, v.reason, v.created_at
+		FROM votes v
+		JOIN users u ON u.id = v.user_id
+		JOIN features f ON f.id = v.feature_id AND f.deleted_at IS NULL
+		WHERE v.feature_id = $1
+		ORDER BY v.created_at DESC, v.id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(query, featureID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature voters: %w", err)
+	}
+	defer rows.Close()
+
+	voters := []votes.VoterInfo{}
+	for rows.Next() {
+		var voter votes.VoterInfo
+		err := rows.Scan(&voter.UserID, &voter.Username, &voter.Category, &voter.Value, &voter.Reason, &voter.VotedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan voter: %w", err)
+		}
+		voters = append(voters, voter)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating voters: %w", err)
+	}
+
+	return voters, nil
+}
+
+// CountByUser returns the number of votes cast by a user
+func (r *FeatureRepository) CountByUser(userID int) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM votes WHERE user_id = $1`
+
+	err := r.db.QueryRow(query, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count user votes: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetVotingStreak returns how many consecutive days, ending today or yesterday, the user
+// cast at least one vote
+func (r *FeatureRepository) GetVotingStreak(userID int) (int, error) {
+	query := `
+		SELECT DISTINCT DATE(created_at) AS day, CURRENT_DATE
+		FROM votes
+		WHERE user_id = $1
+		ORDER BY day DESC
+	`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get vote days: %w", err)
+	}
+	defer rows.Close()
+
+	streak := 0
+	var expected time.Time
+	for rows.Next() {
+		var day, today time.Time
+		if err := rows.Scan(&day, &today); err != nil {
+			return 0, fmt.Errorf("failed to scan vote day: %w", err)
+		}
+
+		if streak == 0 {
+			// A streak is still current if the latest vote was today or yesterday
+			if day.Before(today.AddDate(0, 0, -1)) {
+				break
+			}
+		} else if !day.Equal(expected) {
+			break
+		}
+
+		streak++
+		expected = day.AddDate(0, 0, -1)
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating vote days: %w", err)
+	}
+
+	return streak, nil
+}
+
+// GetVoterPercentile returns the percentage (0-100) of other users who cast fewer votes than
+// the user. Users without votes are at the 0th percentile.
+func (r *FeatureRepository) GetVoterPercentile(userID int) (float64, error) {
+	query := `
+		SELECT vote_total, percentile
+		FROM (
+			SELECT u.id, COUNT(v.id) AS vote_total,
+			       PERCENT_RANK() OVER (ORDER BY COUNT(v.id)) * 100 AS percentile
+			FROM users u
+			LEFT JOIN votes v ON v.user_id = u.id
+			GROUP BY u.id
+		) ranked
+		WHERE id = $1
+	`
+
+	var voteTotal int
+	var percentile float64
+	err := r.db.QueryRow(query, userID).Scan(&voteTotal, &percentile)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("user not found")
+		}
+		return 0, fmt.Errorf("failed to get voter percentile: %w", err)
+	}
+
+	if voteTotal == 0 {
+		return 0, nil
+	}
+
+	return percentile, nil
+}
+
+// GetVoteOverlap returns the features both users voted for in the same direction, and on how
+// many features each cast votes the other did not match; every feature counts once however
+// many categories it was voted in
+func (r *FeatureRepository) GetVoteOverlap(userID, otherUserID int) (*votes.VoteOverlap, error) {
+	overlap := &votes.VoteOverlap{
+		UserID:         userID,
+		OtherUserID:    otherUserID,
+		SharedFeatures: []features.Feature{},
+	}
+
+	sharedQuery := `
+		SELECT DISTINCT f.id, f.title, f.description, f.created_by, u.username,
+		       f.vote_count, f.created_at, f.updated_at
+		FROM votes mine
+		JOIN votes theirs ON theirs.feature_id = mine.feature_id AND theirs.user_id = $2 AND theirs.value = mine.value
+		JOIN features f ON f.id = mine.feature_id
+		LEFT JOIN users u ON f.created_by = u.id
+		WHERE mine.user_id = $1 AND f.deleted_at IS NULL
+		ORDER BY f.vote_count DESC, f.created_at DESC
+	`
+
+	rows, err := r.db.Query(sharedQuery, userID, otherUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared votes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var feature features.Feature
+		err := rows.Scan(
+			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
+			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		feature.HasUserVoted = true
+		overlap.SharedFeatures = append(overlap.SharedFeatures, feature)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating shared votes: %w", err)
+	}
+	overlap.SharedCount = len(overlap.SharedFeatures)
+
+	uniqueQuery := `
+		SELECT
+			(SELECT COUNT(DISTINCT v.feature_id) FROM votes v
+			 WHERE v.user_id = $1
+			   AND NOT EXISTS (SELECT 1 FROM votes o WHERE o.user_id = $2 AND o.feature_id = v.feature_id AND o.value = v.value)
+			   AND v.feature_id IN (SELECT id FROM features WHERE deleted_at IS NULL)),
+			(SELECT COUNT(DISTINCT v.feature_id) FROM votes v
+			 WHERE v.user_id = $2
+			   AND NOT EXISTS (SELECT 1 FROM votes o WHERE o.user_id = $1 AND o.feature_id = v.feature_id AND o.value = v.value)
+			   AND v.feature_id IN (SELECT id FROM features WHERE deleted_at IS NULL))
+	`
+
+	err = r.db.QueryRow(uniqueQuery, userID, otherUserID).Scan(&overlap.OnlyUserCount, &overlap.OnlyOtherCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unique votes: %w", err)
+	}
+
+	return overlap, nil
+}
+
+// GetVoteRemovalImpact projects each feature's vote count as if all of the user's votes were
+// removed, most affected features first. It only reads; nothing is removed.
+func (r *FeatureRepository) GetVoteRemovalImpact(userID int) (*votes.VoteImpact, error) {
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)`, userID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	query := `
+		SELECT f.id, f.title, f.vote_count, COUNT(v.id) AS votes_removed,
+		       f.vote_count - SUM(v.value) AS projected_vote_count
+		FROM votes v
+		JOIN features f ON f.id = v.feature_id AND f.deleted_at IS NULL
+		WHERE v.user_id = $1
+		GROUP BY f.id, f.title, f.vote_count
+		ORDER BY votes_removed DESC, f.vote_count DESC, f.id ASC
+	`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote removal impact: %w", err)
+	}
+	defer rows.Close()
+
+	impact := &votes.VoteImpact{
+		UserID:   userID,
+		Features: []votes.FeatureVoteImpact{},
+	}
+	for rows.Next() {
+		var item votes.FeatureVoteImpact
+		err := rows.Scan(&item.FeatureID, &item.Title, &item.CurrentVoteCount, &item.VotesRemoved, &item.ProjectedVoteCount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan vote removal impact: %w", err)
+		}
+		item.DropsBelow = votes.ThresholdsCrossed(item.CurrentVoteCount, item.ProjectedVoteCount)
+		if len(item.DropsBelow) > 0 {
+			impact.DroppedCount++
+		}
+		impact.TotalVotes += item.VotesRemoved
+		impact.Features = append(impact.Features, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating vote removal impact: %w", err)
+	}
+
+	return impact, nil
+}
+
+// GetStaleFeatures returns unexpired features still in the open status, created at or before
+// createdBefore with at most maxVotes votes, least voted and then oldest first
+func (r *FeatureRepository) GetStaleFeatures(createdBefore time.Time, maxVotes int) ([]features.Feature, error) {
+	query := `
+		SELECT f.id, f.title, f.description, f.created_by, u.username,
+		       f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status
+		FROM features f
+		LEFT JOIN users u ON f.created_by = u.id
+		WHERE f.expired = FALSE AND f.status = 'open' AND f.created_at <= $1 AND f.vote_count <= $2
+		  AND f.deleted_at IS NULL
+		ORDER BY f.vote_count ASC, f.created_at ASC
+	`
+
+	rows, err := r.db.Query(query, createdBefore, maxVotes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stale features: %w", err)
+	}
+	defer rows.Close()
+
+	featuresList := []features.Feature{}
+	for rows.Next() {
+		var feature features.Feature
+		err := rows.Scan(
+			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
+			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.ExpiresAt, &feature.Expired, &feature.Status,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stale feature: %w", err)
+		}
+		featuresList = append(featuresList, feature)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate stale features: %w", err)
+	}
+
+	return featuresList, nil
+}
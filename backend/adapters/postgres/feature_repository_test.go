@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,7 +36,7 @@ func TestFeatureRepository_Create(t *testing.T) {
 			},
 			setup: func() {
 				mock.ExpectQuery(`INSERT INTO features`).
-					WithArgs("Test Feature", "Test Description", 1).
+					WithArgs("Test Feature", "Test Description", 1, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "vote_count", "created_at", "updated_at"}).
 						AddRow(1, 0, now, now))
 			},
@@ -50,11 +51,21 @@ func TestFeatureRepository_Create(t *testing.T) {
 			},
 			setup: func() {
 				mock.ExpectQuery(`INSERT INTO features`).
-					WithArgs("Test Feature", "Test Description", 1).
+					WithArgs("Test Feature", "Test Description", 1, nil).
 					WillReturnError(sql.ErrConnDone)
 			},
 			wantErr: true,
 		},
+		{
+			name: "description too long is rejected before insert",
+			feature: &features.Feature{
+				Title:       "Test Feature",
+				Description: strings.Repeat("d", features.MaxDescriptionLength+1),
+				CreatedBy:   1,
+			},
+			setup:   func() {},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,10 +110,10 @@ func TestFeatureRepository_GetByID(t *testing.T) {
 			id:     1,
 			userID: nil,
 			setup: func() {
-				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at FROM features f LEFT JOIN users u ON f.created_by = u.id WHERE f.id = \$1`).
+				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status, ARRAY\(.+ORDER BY t.name\s*\) AS tags FROM features f LEFT JOIN users u ON f.created_by = u.id WHERE f.id = \$1`).
 					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at"}).
-						AddRow(1, "Test Feature", "Test Description", 1, "testuser", 5, now, now))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status", "tags"}).
+						AddRow(1, "Test Feature", "Test Description", 1, "testuser", 5, now, now, nil, false, "open", "{mobile,ux}"))
 			},
 			want: &features.Feature{
 				ID:              1,
@@ -113,7 +124,9 @@ func TestFeatureRepository_GetByID(t *testing.T) {
 				VoteCount:       5,
 				CreatedAt:       now,
 				UpdatedAt:       now,
+				Status:          "open",
 				HasUserVoted:    false,
+				Tags:            []string{"mobile", "ux"},
 			},
 			wantErr: false,
 		},
@@ -122,14 +135,15 @@ func TestFeatureRepository_GetByID(t *testing.T) {
 			id:     1,
 			userID: intPtr(2),
 			setup: func() {
-				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at FROM features f LEFT JOIN users u ON f.created_by = u.id WHERE f.id = \$1`).
+				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status, ARRAY\(.+ORDER BY t.name\s*\) AS tags FROM features f LEFT JOIN users u ON f.created_by = u.id WHERE f.id = \$1`).
 					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at"}).
-						AddRow(1, "Test Feature", "Test Description", 1, "testuser", 5, now, now))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status", "tags"}).
+						AddRow(1, "Test Feature", "Test Description", 1, "testuser", 5, now, now, nil, false, "open", "{mobile,ux}"))
 
-				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM votes WHERE user_id = \$1 AND feature_id = \$2\)`).
-					WithArgs(2, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery(`SELECT id, user_id, feature_id, category, value, reason, created_at FROM votes WHERE user_id = \$1 AND feature_id = \$2 AND category = \$3`).
+					WithArgs(2, 1, "default").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "category", "value", "reason", "created_at"}).
+						AddRow(3, 2, 1, "default", 1, nil, now))
 			},
 			want: &features.Feature{
 				ID:              1,
@@ -140,7 +154,10 @@ func TestFeatureRepository_GetByID(t *testing.T) {
 				VoteCount:       5,
 				CreatedAt:       now,
 				UpdatedAt:       now,
+				Status:          "open",
 				HasUserVoted:    true,
+				UserVote:        1,
+				Tags:            []string{"mobile", "ux"},
 			},
 			wantErr: false,
 		},
@@ -149,7 +166,7 @@ func TestFeatureRepository_GetByID(t *testing.T) {
 			id:     999,
 			userID: nil,
 			setup: func() {
-				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at FROM features f LEFT JOIN users u ON f.created_by = u.id WHERE f.id = \$1`).
+				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status, ARRAY\(.+ORDER BY t.name\s*\) AS tags FROM features f LEFT JOIN users u ON f.created_by = u.id WHERE f.id = \$1`).
 					WithArgs(999).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -184,12 +201,22 @@ func TestFeatureRepository_GetAll(t *testing.T) {
 
 	repo := NewFeatureRepository(&DB{db})
 	now := time.Now()
+	weekAgo := now.AddDate(0, 0, -7)
+	getAllQuery := func(orderBy string) string {
+		return `SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at, f.expires_at, f.expired, f.status, CASE WHEN v.id IS NOT NULL THEN true ELSE false END as has_user_voted, ARRAY\(.+\) AS tags FROM features f LEFT JOIN users u ON f.created_by = u.id LEFT JOIN votes v ON v.feature_id = f.id AND v.user_id = \$3 AND v.category = 'default' WHERE f.deleted_at IS NULL AND \(\$4 = '' OR f.status = \$4\) AND \(\$5 = '' OR EXISTS\(.+ AND t.name = \$5\)\) AND \(\$6::timestamp IS NULL OR f.created_at >= \$6\) AND \(\$7::timestamp IS NULL OR f.created_at <= \$7\) ORDER BY ` + orderBy + ` LIMIT \$1 OFFSET \$2`
+	}
+	getAllColumns := []string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status", "has_user_voted", "tags"}
 
 	tests := []struct {
 		name     string
 		page     int
 		perPage  int
 		userID   *int
+		sort     features.SortOrder
+		status   string
+		tag      string
+		after    *time.Time
+		before   *time.Time
 		setup    func()
 		want     []features.Feature
 		wantTotal int
@@ -200,17 +227,18 @@ func TestFeatureRepository_GetAll(t *testing.T) {
 			page:    1,
 			perPage: 10,
 			userID:  nil,
+			sort:    features.SortVotes,
 			setup: func() {
 				// Mock count query
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features`).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
 
 				// Mock features query
-				mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at FROM features f LEFT JOIN users u ON f.created_by = u.id ORDER BY f.created_at DESC LIMIT \$1 OFFSET \$2`).
-					WithArgs(10, 0).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at"}).
-						AddRow(1, "Feature 1", "Description 1", 1, "user1", 3, now, now).
-						AddRow(2, "Feature 2", "Description 2", 2, "user2", 1, now, now))
+				mock.ExpectQuery(getAllQuery(`f.vote_count DESC, f.created_at DESC`)).
+					WithArgs(10, 0, nil, "", "", nil, nil).
+					WillReturnRows(sqlmock.NewRows(getAllColumns).
+						AddRow(1, "Feature 1", "Description 1", 1, "user1", 3, now, now, nil, false, "open", false, nil).
+						AddRow(2, "Feature 2", "Description 2", 2, "user2", 1, now, now, nil, false, "open", false, nil))
 			},
 			want: []features.Feature{
 				{
@@ -222,6 +250,7 @@ func TestFeatureRepository_GetAll(t *testing.T) {
 					VoteCount:       3,
 					CreatedAt:       now,
 					UpdatedAt:       now,
+					Status:          "open",
 					HasUserVoted:    false,
 				},
 				{
@@ -233,12 +262,195 @@ func TestFeatureRepository_GetAll(t *testing.T) {
 					VoteCount:       1,
 					CreatedAt:       now,
 					UpdatedAt:       now,
+					Status:          "open",
 					HasUserVoted:    false,
 				},
 			},
 			wantTotal: 2,
 			wantErr:   false,
 		},
+		{
+			name:    "vote status resolved in the same query",
+			page:    1,
+			perPage: 10,
+			userID:  intPtr(7),
+			sort:    features.SortVotes,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+				mock.ExpectQuery(getAllQuery(`f.vote_count DESC, f.created_at DESC`)).
+					WithArgs(10, 0, 7, "", "", nil, nil).
+					WillReturnRows(sqlmock.NewRows(getAllColumns).
+						AddRow(1, "Feature 1", "Description 1", 1, "user1", 3, now, now, nil, false, "open", true, nil).
+						AddRow(2, "Feature 2", "Description 2", 2, "user2", 1, now, now, nil, false, "open", false, nil))
+			},
+			want: []features.Feature{
+				{
+					ID:            1,
+					Title:         "Feature 1",
+					Description:   "Description 1",
+					CreatedBy:     1,
+					CreatedByUser: stringPtr("user1"),
+					VoteCount:     3,
+					CreatedAt:     now,
+					UpdatedAt:     now,
+					Status:        "open",
+					HasUserVoted:  true,
+				},
+				{
+					ID:            2,
+					Title:         "Feature 2",
+					Description:   "Description 2",
+					CreatedBy:     2,
+					CreatedByUser: stringPtr("user2"),
+					VoteCount:     1,
+					CreatedAt:     now,
+					UpdatedAt:     now,
+					Status:        "open",
+					HasUserVoted:  false,
+				},
+			},
+			wantTotal: 2,
+			wantErr:   false,
+		},
+		{
+			name:    "oldest first",
+			page:    2,
+			perPage: 1,
+			userID:  nil,
+			sort:    features.SortOldest,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+				mock.ExpectQuery(getAllQuery(`f.created_at ASC, f.id ASC`)).
+					WithArgs(1, 1, nil, "", "", nil, nil).
+					WillReturnRows(sqlmock.NewRows(getAllColumns).
+						AddRow(2, "Feature 2", "Description 2", 2, "user2", 1, now, now, nil, false, "open", false, nil))
+			},
+			want: []features.Feature{
+				{
+					ID:            2,
+					Title:         "Feature 2",
+					Description:   "Description 2",
+					CreatedBy:     2,
+					CreatedByUser: stringPtr("user2"),
+					VoteCount:     1,
+					CreatedAt:     now,
+					UpdatedAt:     now,
+					Status:        "open",
+				},
+			},
+			wantTotal: 2,
+			wantErr:   false,
+		},
+		{
+			name:    "newest first",
+			page:    1,
+			perPage: 10,
+			userID:  nil,
+			sort:    features.SortNewest,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+				mock.ExpectQuery(getAllQuery(`f.created_at DESC, f.id DESC`)).
+					WithArgs(10, 0, nil, "", "", nil, nil).
+					WillReturnRows(sqlmock.NewRows(getAllColumns))
+			},
+			want:      nil,
+			wantTotal: 0,
+			wantErr:   false,
+		},
+		{
+			name:    "status filter",
+			page:    1,
+			perPage: 10,
+			userID:  nil,
+			sort:    features.SortVotes,
+			status:  features.StatusPlanned,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features f WHERE f.deleted_at IS NULL AND \(\$1 = '' OR f.status = \$1\) AND \(\$2 = '' OR EXISTS\(.+ AND t.name = \$2\)\)`).
+					WithArgs("planned", "", nil, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+				mock.ExpectQuery(getAllQuery(`f.vote_count DESC, f.created_at DESC`)).
+					WithArgs(10, 0, nil, "planned", "", nil, nil).
+					WillReturnRows(sqlmock.NewRows(getAllColumns).
+						AddRow(3, "Feature 3", "Description 3", 1, "user1", 8, now, now, nil, false, "planned", false, nil))
+			},
+			want: []features.Feature{
+				{
+					ID:            3,
+					Title:         "Feature 3",
+					Description:   "Description 3",
+					CreatedBy:     1,
+					CreatedByUser: stringPtr("user1"),
+					VoteCount:     8,
+					CreatedAt:     now,
+					UpdatedAt:     now,
+					Status:        "planned",
+				},
+			},
+			wantTotal: 1,
+			wantErr:   false,
+		},
+		{
+			name:    "tag filter",
+			page:    1,
+			perPage: 10,
+			userID:  nil,
+			sort:    features.SortVotes,
+			tag:     "mobile",
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features`).
+					WithArgs("", "mobile", nil, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+				mock.ExpectQuery(getAllQuery(`f.vote_count DESC, f.created_at DESC`)).
+					WithArgs(10, 0, nil, "", "mobile", nil, nil).
+					WillReturnRows(sqlmock.NewRows(getAllColumns).
+						AddRow(4, "Feature 4", "Description 4", 2, "user2", 6, now, now, nil, false, "open", false, "{mobile,ux}"))
+			},
+			want: []features.Feature{
+				{
+					ID:            4,
+					Title:         "Feature 4",
+					Description:   "Description 4",
+					CreatedBy:     2,
+					CreatedByUser: stringPtr("user2"),
+					VoteCount:     6,
+					CreatedAt:     now,
+					UpdatedAt:     now,
+					Status:        "open",
+					Tags:          []string{"mobile", "ux"},
+				},
+			},
+			wantTotal: 1,
+			wantErr:   false,
+		},
+		{
+			name:    "creation time range",
+			page:    1,
+			perPage: 10,
+			userID:  nil,
+			sort:    features.SortVotes,
+			after:   &weekAgo,
+			before:  &now,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features f WHERE .+ AND \(\$3::timestamp IS NULL OR f.created_at >= \$3\) AND \(\$4::timestamp IS NULL OR f.created_at <= \$4\)`).
+					WithArgs("", "", weekAgo, now).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+				mock.ExpectQuery(getAllQuery(`f.vote_count DESC, f.created_at DESC`)).
+					WithArgs(10, 0, nil, "", "", weekAgo, now).
+					WillReturnRows(sqlmock.NewRows(getAllColumns))
+			},
+			want:      nil,
+			wantTotal: 0,
+			wantErr:   false,
+		},
 		{
 			name:    "count query error",
 			page:    1,
@@ -258,7 +470,7 @@ func TestFeatureRepository_GetAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			features, total, err := repo.GetAll(tt.page, tt.perPage, tt.userID)
+			features, total, err := repo.GetAll(tt.page, tt.perPage, tt.userID, tt.sort, tt.status, tt.tag, tt.after, tt.before)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -282,11 +494,14 @@ func TestFeatureRepository_Update(t *testing.T) {
 
 	repo := NewFeatureRepository(&DB{db})
 
+	deadline := time.Now().Add(24 * time.Hour)
+
 	tests := []struct {
 		name        string
 		id          int
 		title       *string
 		description *string
+		expiresAt   *time.Time
 		setup       func()
 		wantErr     bool
 	}{
@@ -296,7 +511,7 @@ func TestFeatureRepository_Update(t *testing.T) {
 			title:       stringPtr("Updated Title"),
 			description: nil,
 			setup: func() {
-				mock.ExpectExec(`UPDATE features SET title = \$1 WHERE id = \$2`).
+				mock.ExpectExec(`UPDATE features SET title = \$1, updated_at = CURRENT_TIMESTAMP WHERE id = \$2`).
 					WithArgs("Updated Title", 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
@@ -308,12 +523,23 @@ func TestFeatureRepository_Update(t *testing.T) {
 			title:       stringPtr("Updated Title"),
 			description: stringPtr("Updated Description"),
 			setup: func() {
-				mock.ExpectExec(`UPDATE features SET title = \$1, description = \$2 WHERE id = \$3`).
+				mock.ExpectExec(`UPDATE features SET title = \$1, description = \$2, updated_at = CURRENT_TIMESTAMP WHERE id = \$3`).
 					WithArgs("Updated Title", "Updated Description", 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
 		},
+		{
+			name:      "new expiry reopens voting",
+			id:        1,
+			expiresAt: &deadline,
+			setup: func() {
+				mock.ExpectExec(`UPDATE features SET expires_at = \$1, expired = FALSE, updated_at = CURRENT_TIMESTAMP WHERE id = \$2`).
+					WithArgs(deadline, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
 		{
 			name:        "no fields to update",
 			id:          1,
@@ -328,7 +554,7 @@ func TestFeatureRepository_Update(t *testing.T) {
 			title:       stringPtr("Updated Title"),
 			description: nil,
 			setup: func() {
-				mock.ExpectExec(`UPDATE features SET title = \$1 WHERE id = \$2`).
+				mock.ExpectExec(`UPDATE features SET title = \$1, updated_at = CURRENT_TIMESTAMP WHERE id = \$2`).
 					WithArgs("Updated Title", 999).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
@@ -340,7 +566,7 @@ func TestFeatureRepository_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			err := repo.Update(tt.id, tt.title, tt.description)
+			err := repo.Update(tt.id, tt.title, tt.description, tt.expiresAt)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -371,7 +597,7 @@ func TestFeatureRepository_FeatureExists(t *testing.T) {
 			name: "feature exists",
 			id:   1,
 			setup: func() {
-				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM features WHERE id = \$1\)`).
+				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM features WHERE id = \$1 AND deleted_at IS NULL\)`).
 					WithArgs(1).
 					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 			},
@@ -382,7 +608,7 @@ func TestFeatureRepository_FeatureExists(t *testing.T) {
 			name: "feature does not exist",
 			id:   999,
 			setup: func() {
-				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM features WHERE id = \$1\)`).
+				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM features WHERE id = \$1 AND deleted_at IS NULL\)`).
 					WithArgs(999).
 					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 			},
@@ -393,7 +619,7 @@ func TestFeatureRepository_FeatureExists(t *testing.T) {
 			name: "database error",
 			id:   1,
 			setup: func() {
-				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM features WHERE id = \$1\)`).
+				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM features WHERE id = \$1 AND deleted_at IS NULL\)`).
 					WithArgs(1).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -432,114 +658,333 @@ func TestFeatureRepository_AddVote(t *testing.T) {
 		name      string
 		userID    int
 		featureID int
+		category  string
+		value     int
+		reason    *string
 		setup     func()
 		wantErr   bool
+		wantAdded bool
 	}{
 		{
 			name:      "successful vote addition",
 			userID:    1,
 			featureID: 1,
+			category:  votes.DefaultCategory,
+			value:     votes.Upvote,
 			setup: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id\) VALUES \(\$1, \$2\)`).
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id, category, value, reason\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+					WithArgs(1, 1, "default", 1, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count \+ \$2 WHERE id = \$1`).
 					WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO last_vote_actions`).
+					WithArgs(1, 1, "default", "add").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr:   false,
+			wantAdded: true,
+		},
+		{
+			name:      "vote with reason",
+			userID:    1,
+			featureID: 2,
+			category:  votes.DefaultCategory,
+			value:     votes.Upvote,
+			reason:    stringPtr("We need this for audits"),
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id, category, value, reason\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+					WithArgs(1, 2, "default", 1, "We need this for audits").
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count \+ \$2 WHERE id = \$1`).
+					WithArgs(2, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO last_vote_actions`).
+					WithArgs(1, 2, "default", "add").
+					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
 			},
+			wantErr:   false,
+			wantAdded: true,
+		},
+		{
+			name:      "conflicting insert is a no-op",
+			userID:    1,
+			featureID: 1,
+			category:  votes.DefaultCategory,
+			value:     votes.Upvote,
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id, category, value, reason\) VALUES \(\$1, \$2, \$3, \$4, \$5\)\s+ON CONFLICT \(user_id, feature_id, category\) DO NOTHING`).
+					WithArgs(1, 1, "default", 1, nil).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				// No vote count update or last action when nothing was inserted
+				mock.ExpectRollback()
+			},
 			wantErr: false,
 		},
 		{
-			name:      "database error",
+			name:      "vote in a second category on the same feature",
 			userID:    1,
 			featureID: 1,
+			category:  "would_pay",
+			value:     votes.Upvote,
 			setup: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id\) VALUES \(\$1, \$2\)`).
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id, category, value, reason\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+					WithArgs(1, 1, "would_pay", 1, nil).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count \+ \$2 WHERE id = \$1`).
 					WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO last_vote_actions`).
+					WithArgs(1, 1, "would_pay", "add").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr:   false,
+			wantAdded: true,
+		},
+		{
+			name:      "downvote lowers the vote count",
+			userID:    2,
+			featureID: 1,
+			category:  votes.DefaultCategory,
+			value:     votes.Downvote,
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id, category, value, reason\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+					WithArgs(2, 1, "default", -1, nil).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count \+ \$2 WHERE id = \$1`).
+					WithArgs(1, -1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO last_vote_actions`).
+					WithArgs(2, 1, "default", "add").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr:   false,
+			wantAdded: true,
+		},
+		{
+			name:      "database error",
+			userID:    1,
+			featureID: 1,
+			category:  votes.DefaultCategory,
+			value:     votes.Upvote,
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`INSERT INTO votes \(user_id, feature_id, category, value, reason\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+					WithArgs(1, 1, "default", 1, nil).
 					WillReturnError(sql.ErrConnDone)
 				mock.ExpectRollback()
 			},
 			wantErr: true,
 		},
+		{
+			name:      "voting closed after deadline",
+			userID:    1,
+			featureID: 3,
+			category:  votes.DefaultCategory,
+			value:     votes.Upvote,
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR \(expires_at IS NOT NULL AND expires_at <= CURRENT_TIMESTAMP\) OR status IN \('completed', 'rejected'\) FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			err := repo.AddVote(tt.userID, tt.featureID)
+			added, err := repo.AddVote(tt.userID, tt.featureID, tt.category, tt.value, tt.reason)
 
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tt.wantAdded, added)
 
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestFeatureRepository_HasUserVoted(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	require.NoError(t, err)
-	defer db.Close()
-
-	repo := NewFeatureRepository(&DB{db})
-
+func TestFeatureRepository_ChangeVoteDirection(t *testing.T) {
 	tests := []struct {
-		name      string
-		userID    int
-		featureID int
-		setup     func()
-		want      bool
-		wantErr   bool
+		name        string
+		setup       func(sqlmock.Sqlmock)
+		wantErr     error
+		wantChanged bool
 	}{
 		{
-			name:      "user has voted",
-			userID:    1,
-			featureID: 1,
-			setup: func() {
-				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM votes WHERE user_id = \$1 AND feature_id = \$2\)`).
-					WithArgs(1, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			name: "upvote turned down moves the count by two",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR .* FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`UPDATE votes SET value = \$4 WHERE user_id = \$1 AND feature_id = \$2 AND category = \$3 AND value <> \$4`).
+					WithArgs(2, 1, votes.DefaultCategory, votes.Downvote).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count \+ \$2 WHERE id = \$1`).
+					WithArgs(1, -2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
 			},
-			want:    true,
-			wantErr: false,
+			wantChanged: true,
 		},
 		{
-			name:      "user has not voted",
-			userID:    1,
-			featureID: 1,
-			setup: func() {
-				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM votes WHERE user_id = \$1 AND feature_id = \$2\)`).
-					WithArgs(1, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			name: "vote already in that direction is left alone",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR .* FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(false))
+				mock.ExpectExec(`UPDATE votes SET value = \$4`).
+					WithArgs(2, 1, votes.DefaultCategory, votes.Downvote).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
 			},
-			want:    false,
-			wantErr: false,
+		},
+		{
+			name: "voting closed",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT expired OR .* FROM features WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"closed"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			wantErr: votes.ErrVotingClosed,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.setup()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
 
-			hasVoted, err := repo.HasUserVoted(tt.userID, tt.featureID)
+			repo := NewFeatureRepository(&DB{db})
+			tt.setup(mock)
 
-			if tt.wantErr {
-				assert.Error(t, err)
-				assert.False(t, hasVoted)
+			changed, err := repo.ChangeVoteDirection(2, 1, votes.DefaultCategory, votes.Downvote)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.want, hasVoted)
 			}
-
+			assert.Equal(t, tt.wantChanged, changed)
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
+func TestFeatureRepository_GetUserVote(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	voteQuery := `SELECT id, user_id, feature_id, category, value, reason, created_at FROM votes WHERE user_id = \$1 AND feature_id = \$2 AND category = \$3`
+
+	t.Run("returns the vote with its direction", func(t *testing.T) {
+		mock.ExpectQuery(voteQuery).
+			WithArgs(1, 1, "default").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "category", "value", "reason", "created_at"}).
+				AddRow(7, 1, 1, "default", -1, nil, now))
+
+		vote, err := repo.GetUserVote(1, 1, votes.DefaultCategory)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, vote.ID)
+		assert.Equal(t, votes.Downvote, vote.Value)
+	})
+
+	t.Run("user has not voted", func(t *testing.T) {
+		mock.ExpectQuery(voteQuery).
+			WithArgs(1, 2, "default").
+			WillReturnError(sql.ErrNoRows)
+
+		vote, err := repo.GetUserVote(1, 2, votes.DefaultCategory)
+
+		assert.EqualError(t, err, "vote not found")
+		assert.Nil(t, vote)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_HasUserVotedBulk(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	mock.ExpectQuery(`SELECT DISTINCT feature_id FROM votes WHERE user_id = \$1 AND feature_id = ANY\(\$2\)`).
+		WithArgs(1, "{3,5,8}").
+		WillReturnRows(sqlmock.NewRows([]string{"feature_id"}).AddRow(3).AddRow(8))
+
+	voted, err := repo.HasUserVotedBulk(1, []int{3, 5, 8})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int]bool{3: true, 5: false, 8: true}, voted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestFeatureRepository_GetUserVotes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -559,15 +1004,15 @@ func TestFeatureRepository_GetUserVotes(t *testing.T) {
 			name:   "successful retrieval",
 			userID: 1,
 			setup: func() {
-				mock.ExpectQuery(`SELECT v.id, v.user_id, v.feature_id, v.created_at FROM votes v WHERE v.user_id = \$1 ORDER BY v.created_at DESC`).
+				mock.ExpectQuery(`SELECT v.id, v.user_id, v.feature_id, v.category, v.value, v.reason, v.created_at FROM votes v WHERE v.user_id = \$1 ORDER BY v.created_at DESC`).
 					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "created_at"}).
-						AddRow(1, 1, 10, now).
-						AddRow(2, 1, 20, now))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "category", "value", "reason", "created_at"}).
+						AddRow(1, 1, 10, "default", 1, "Blocks our rollout", now).
+						AddRow(2, 1, 20, "would_pay", -1, nil, now))
 			},
 			want: []votes.Vote{
-				{ID: 1, UserID: 1, FeatureID: 10, CreatedAt: now},
-				{ID: 2, UserID: 1, FeatureID: 20, CreatedAt: now},
+				{ID: 1, UserID: 1, FeatureID: 10, Category: "default", Value: 1, Reason: stringPtr("Blocks our rollout"), CreatedAt: now},
+				{ID: 2, UserID: 1, FeatureID: 20, Category: "would_pay", Value: -1, CreatedAt: now},
 			},
 			wantErr: false,
 		},
@@ -575,9 +1020,9 @@ func TestFeatureRepository_GetUserVotes(t *testing.T) {
 			name:   "no votes found",
 			userID: 1,
 			setup: func() {
-				mock.ExpectQuery(`SELECT v.id, v.user_id, v.feature_id, v.created_at FROM votes v WHERE v.user_id = \$1 ORDER BY v.created_at DESC`).
+				mock.ExpectQuery(`SELECT v.id, v.user_id, v.feature_id, v.category, v.value, v.reason, v.created_at FROM votes v WHERE v.user_id = \$1 ORDER BY v.created_at DESC`).
 					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "created_at"}))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "category", "value", "reason", "created_at"}))
 			},
 			want:    nil,
 			wantErr: false,
@@ -610,4 +1055,1236 @@ func intPtr(i int) *int {
 
 func stringPtr(s string) *string {
 	return &s
-}
\ No newline at end of file
+}
+func TestFeatureRepository_GetUserVotesWithFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	created := now.Add(-48 * time.Hour)
+
+	mock.ExpectQuery(`SELECT v.id, v.user_id, v.feature_id, v.category, v.value, v.reason, v.created_at,\s+f.title, f.vote_count, f.created_at FROM votes v JOIN features f ON f.id = v.feature_id WHERE v.user_id = \$1 AND f.deleted_at IS NULL ORDER BY v.created_at DESC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "category", "value", "reason", "created_at", "title", "vote_count", "created_at"}).
+			AddRow(1, 1, 10, "default", 1, nil, now, "Dark mode", 12, created))
+
+	got, err := repo.GetUserVotesWithFeatures(1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []votes.VoteWithFeature{{
+		Vote:    votes.Vote{ID: 1, UserID: 1, FeatureID: 10, Category: "default", Value: 1, CreatedAt: now},
+		Feature: votes.VotedFeature{ID: 10, Title: "Dark mode", VoteCount: 12, CreatedAt: created},
+	}}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetVotersByFeature(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	reason := "Needed for mobile"
+
+	mock.ExpectQuery(`SELECT v.user_id, u.username, v.category, v.value, v.reason, v.created_at FROM votes v JOIN users u ON u.id = v.user_id JOIN features f ON f.id = v.feature_id AND f.deleted_at IS NULL WHERE v.feature_id = \$1 ORDER BY v.created_at DESC, v.id DESC LIMIT \$2`).
+		WithArgs(1, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username", "category", "value", "reason", "created_at"}).
+			AddRow(3, "carol", "default", 1, reason, now).
+			AddRow(2, "bob", "default", -1, nil, now.Add(-time.Hour)))
+
+	voters, err := repo.GetVotersByFeature(1, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, []votes.VoterInfo{
+		{UserID: 3, Username: "carol", Category: "default", Value: 1, Reason: &reason, VotedAt: now},
+		{UserID: 2, Username: "bob", Category: "default", Value: -1, VotedAt: now.Add(-time.Hour)},
+	}, voters)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetTop(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	columns := []string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "window_votes"}
+
+	tests := []struct {
+		name       string
+		window     features.LeaderboardWindow
+		setup      func(since *time.Time)
+		wantWindow int
+	}{
+		{
+			name:   "week counts votes since seven days ago",
+			window: features.WindowWeek,
+			setup: func(since *time.Time) {
+				mock.ExpectQuery(`SUM\(v.value\) as window_votes.*JOIN votes v ON v.feature_id = f.id AND v.created_at >= \$1.*GROUP BY f.id, u.username\s+HAVING SUM\(v.value\) > 0.*LIMIT \$2`).
+					WithArgs(*since, 5).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Dark mode", "Desc", 1, "alice", 40, now, now, 3))
+			},
+			wantWindow: 3,
+		},
+		{
+			name:   "month counts votes since one month ago",
+			window: features.WindowMonth,
+			setup: func(since *time.Time) {
+				mock.ExpectQuery(`SUM\(v.value\) as window_votes.*JOIN votes v ON v.feature_id = f.id AND v.created_at >= \$1.*GROUP BY f.id, u.username\s+HAVING SUM\(v.value\) > 0.*LIMIT \$2`).
+					WithArgs(*since, 5).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Dark mode", "Desc", 1, "alice", 40, now, now, 12))
+			},
+			wantWindow: 12,
+		},
+		{
+			name:   "all uses the stored vote count",
+			window: features.WindowAll,
+			setup: func(since *time.Time) {
+				mock.ExpectQuery(`f.vote_count as window_votes.*ORDER BY f.vote_count DESC, f.created_at DESC\s+LIMIT \$1`).
+					WithArgs(5).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Dark mode", "Desc", 1, "alice", 40, now, now, 40))
+			},
+			wantWindow: 40,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			since := tt.window.Since(now)
+			tt.setup(since)
+
+			ranked, err := repo.GetTop(since, 5)
+
+			assert.NoError(t, err)
+			require.Len(t, ranked, 1)
+			assert.Equal(t, tt.wantWindow, ranked[0].WindowVoteCount)
+			assert.Equal(t, 40, ranked[0].VoteCount)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_RemoveVotes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	tests := []struct {
+		name        string
+		featureIDs  []int
+		setup       func()
+		wantRemoved int
+		wantErr     bool
+	}{
+		{
+			name:       "removes every category vote on voted features, adjusts their counts and records the last removed category",
+			featureIDs: []int{3, 5, 8},
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM votes WHERE user_id = \$1 AND feature_id = ANY\(\$2\) RETURNING feature_id, category, value`).
+					WithArgs(1, "{3,5,8}").
+					WillReturnRows(sqlmock.NewRows([]string{"feature_id", "category", "value"}).AddRow(3, "default", 1).AddRow(8, "default", 1).AddRow(8, "would_pay", -1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count - \(SELECT SUM\(r.value\) FROM unnest\(\$1::int\[\], \$2::int\[\]\) AS r\(id, value\) WHERE r.id = features.id\)\s+WHERE id = ANY\(\$1\)`).
+					WithArgs("{3,8,8}", "{1,1,-1}").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(`INSERT INTO last_vote_actions`).
+					WithArgs(1, 8, "would_pay", "remove").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantRemoved: 3,
+		},
+		{
+			name:       "no matching votes skips the count update",
+			featureIDs: []int{4},
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM votes WHERE user_id = \$1 AND feature_id = ANY\(\$2\) RETURNING feature_id, category, value`).
+					WithArgs(1, "{4}").
+					WillReturnRows(sqlmock.NewRows([]string{"feature_id", "category", "value"}))
+				mock.ExpectCommit()
+			},
+			wantRemoved: 0,
+		},
+		{
+			name:       "delete error rolls back",
+			featureIDs: []int{3},
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM votes`).
+					WithArgs(1, "{3}").
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			removed, err := repo.RemoveVotes(1, tt.featureIDs)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRemoved, removed)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_GetLastVoteAction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		setup      func()
+		wantAction *votes.VoteAction
+		wantErr    string
+	}{
+		{
+			name: "returns the latest action",
+			setup: func() {
+				mock.ExpectQuery(`SELECT user_id, feature_id, category, action, created_at\s+FROM last_vote_actions\s+WHERE user_id = \$1`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "feature_id", "category", "action", "created_at"}).
+						AddRow(1, 4, "would_pay", "add", now))
+			},
+			wantAction: &votes.VoteAction{UserID: 1, FeatureID: 4, Category: "would_pay", Action: votes.ActionAdd, CreatedAt: now},
+		},
+		{
+			name: "no action recorded",
+			setup: func() {
+				mock.ExpectQuery(`SELECT user_id, feature_id, category, action, created_at\s+FROM last_vote_actions`).
+					WithArgs(1).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: "vote action not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			action, err := repo.GetLastVoteAction(1)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, action)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAction, action)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_UndoVote(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr string
+	}{
+		{
+			name: "removes the vote and clears the action",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM votes WHERE user_id = \$1 AND feature_id = \$2 AND category = \$3 RETURNING value`).
+					WithArgs(1, 4, "default").
+					WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(-1))
+				mock.ExpectExec(`UPDATE features SET vote_count = vote_count - \$2 WHERE id = \$1`).
+					WithArgs(4, -1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM last_vote_actions WHERE user_id = \$1`).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "vote already gone",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM votes WHERE user_id = \$1 AND feature_id = \$2 AND category = \$3 RETURNING value`).
+					WithArgs(1, 4, "default").
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: "vote not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := repo.UndoVote(1, 4, votes.DefaultCategory)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_GetVotingStreak(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	today := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) time.Time { return today.AddDate(0, 0, -n) }
+
+	tests := []struct {
+		name       string
+		days       []time.Time
+		wantStreak int
+	}{
+		{
+			name:       "continuous streak ending today",
+			days:       []time.Time{today, daysAgo(1), daysAgo(2)},
+			wantStreak: 3,
+		},
+		{
+			name:       "continuous streak ending yesterday",
+			days:       []time.Time{daysAgo(1), daysAgo(2)},
+			wantStreak: 2,
+		},
+		{
+			name:       "broken streak counts only the latest run",
+			days:       []time.Time{today, daysAgo(1), daysAgo(3), daysAgo(4)},
+			wantStreak: 2,
+		},
+		{
+			name:       "last vote too long ago",
+			days:       []time.Time{daysAgo(2), daysAgo(3)},
+			wantStreak: 0,
+		},
+		{
+			name:       "no votes",
+			wantStreak: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := sqlmock.NewRows([]string{"day", "current_date"})
+			for _, day := range tt.days {
+				rows.AddRow(day, today)
+			}
+			mock.ExpectQuery(`SELECT DISTINCT DATE\(created_at\) AS day, CURRENT_DATE\s+FROM votes\s+WHERE user_id = \$1\s+ORDER BY day DESC`).
+				WithArgs(1).
+				WillReturnRows(rows)
+
+			streak, err := repo.GetVotingStreak(1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStreak, streak)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_GetChangesSince(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	since := time.Date(2026, 10, 10, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT\s+\(SELECT COUNT\(\*\) FROM features WHERE created_at > \$2 AND created_by <> \$1 AND deleted_at IS NULL\),.*WHERE f.created_by = \$1 AND v.user_id <> \$1 AND v.created_at > \$2 AND f.deleted_at IS NULL\)`).
+		WithArgs(1, since).
+		WillReturnRows(sqlmock.NewRows([]string{"new_features", "new_votes"}).AddRow(4, 7))
+
+	summary, err := repo.GetChangesSince(1, since)
+
+	assert.NoError(t, err)
+	assert.Equal(t, features.ChangeSummary{Since: &since, NewFeatures: 4, NewVotesOnMyFeatures: 7}, summary)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetRankHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	day1 := time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	day3 := day1.AddDate(0, 0, 2)
+
+	rows := sqlmock.NewRows([]string{"snapshot_date", "feature_id", "vote_count"}).
+		// Day 1: feature 1 trails both others
+		AddRow(day1, 1, 2).
+		AddRow(day1, 2, 5).
+		AddRow(day1, 3, 4).
+		// Day 2: tied with feature 3 behind feature 2
+		AddRow(day2, 1, 4).
+		AddRow(day2, 2, 6).
+		AddRow(day2, 3, 4).
+		// Day 3: takes the lead
+		AddRow(day3, 1, 9).
+		AddRow(day3, 2, 7).
+		AddRow(day3, 3, 4)
+	mock.ExpectQuery(`SELECT snapshot_date, feature_id, vote_count\s+FROM feature_vote_snapshots\s+WHERE snapshot_date > CURRENT_DATE - \$1::int`).
+		WithArgs(30, 1).
+		WillReturnRows(rows)
+
+	history, err := repo.GetRankHistory(1, 30)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []features.RankPoint{
+		{Date: day1, VoteCount: 2, Rank: 3},
+		{Date: day2, VoteCount: 4, Rank: 2},
+		{Date: day3, VoteCount: 9, Rank: 1},
+	}, history)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetUserStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	title := "Dark mode"
+
+	tests := []struct {
+		name      string
+		setup     func()
+		wantStats features.UserStats
+	}{
+		{
+			name: "aggregates the user's features",
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\), COALESCE\(SUM\(vote_count\), 0\)\s+FROM features\s+WHERE created_by = \$1 AND deleted_at IS NULL\s+GROUP BY created_by`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count", "sum"}).AddRow(4, 10))
+				mock.ExpectQuery(`SELECT title\s+FROM features\s+WHERE created_by = \$1 AND deleted_at IS NULL\s+ORDER BY vote_count DESC.*LIMIT 1`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"title"}).AddRow(title))
+			},
+			wantStats: features.UserStats{
+				UserID:                 1,
+				FeatureCount:           4,
+				TotalVotesReceived:     10,
+				AverageVotesPerFeature: 2.5,
+				MostVotedFeatureTitle:  &title,
+			},
+		},
+		{
+			name: "user without features",
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\), COALESCE\(SUM\(vote_count\), 0\)`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count", "sum"}))
+			},
+			wantStats: features.UserStats{UserID: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			stats, err := repo.GetUserStats(1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStats, stats)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_GetTeamPicks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	columns := []string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "has_user_voted", "teammate_votes"}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		wantPicks int
+		wantErr   string
+	}{
+		{
+			name: "counts only votes from the viewer's teammates",
+			setup: func() {
+				mock.ExpectQuery(`SELECT team_id FROM users WHERE id = \$1`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(7))
+				mock.ExpectQuery(`JOIN votes v ON v.feature_id = f.id AND v.value = 1\s+JOIN users tm ON tm.id = v.user_id AND tm.team_id = \$1 AND tm.id <> \$2\s+WHERE f.deleted_at IS NULL\s+GROUP BY f.id, u.username\s+ORDER BY teammate_votes DESC.*LIMIT \$3`).
+					WithArgs(int64(7), 1, 10).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(4, "Dark mode", "Desc", 2, "bob", 9, now, now, false, 3).
+						AddRow(2, "Export to CSV", "Desc", 3, "carol", 20, now, now, true, 1))
+			},
+			wantPicks: 2,
+		},
+		{
+			name: "user without a team",
+			setup: func() {
+				mock.ExpectQuery(`SELECT team_id FROM users WHERE id = \$1`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(nil))
+			},
+			wantErr: "user has no team",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			picks, err := repo.GetTeamPicks(1, 10)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				require.Len(t, picks, tt.wantPicks)
+				assert.Equal(t, 3, picks[0].TeammateVoteCount)
+				assert.True(t, picks[1].HasUserVoted)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_ExpireFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectExec(`UPDATE features\s+SET expired = TRUE\s+WHERE expired = FALSE AND expires_at IS NOT NULL AND expires_at <= \$1`).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	expired, err := repo.ExpireFeatures(now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, expired)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetVoteOverlap(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT DISTINCT f.id, f.title.*FROM votes mine\s+JOIN votes theirs ON theirs.feature_id = mine.feature_id AND theirs.user_id = \$2 AND theirs.value = mine.value.*WHERE mine.user_id = \$1 AND f.deleted_at IS NULL`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at"}).
+			AddRow(4, "Dark mode", "Desc", 3, "carol", 9, now, now))
+	mock.ExpectQuery(`SELECT COUNT\(DISTINCT v.feature_id\) FROM votes v\s+WHERE v.user_id = \$1\s+AND NOT EXISTS \(SELECT 1 FROM votes o WHERE o.user_id = \$2 AND o.feature_id = v.feature_id AND o.value = v.value\).*` +
+		`SELECT COUNT\(DISTINCT v.feature_id\) FROM votes v\s+WHERE v.user_id = \$2\s+AND NOT EXISTS \(SELECT 1 FROM votes o WHERE o.user_id = \$1 AND o.feature_id = v.feature_id AND o.value = v.value\)`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"only_user", "only_other"}).AddRow(2, 5))
+
+	overlap, err := repo.GetVoteOverlap(1, 2)
+
+	require.NoError(t, err)
+	require.Len(t, overlap.SharedFeatures, 1)
+	assert.Equal(t, 4, overlap.SharedFeatures[0].ID)
+	assert.True(t, overlap.SharedFeatures[0].HasUserVoted)
+	assert.Equal(t, 1, overlap.SharedCount)
+	assert.Equal(t, 2, overlap.OnlyUserCount)
+	assert.Equal(t, 5, overlap.OnlyOtherCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetVoteOverlap_MultiCategoryVotes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	// Both users voted for feature 4 in the default and ux categories; the self-join yields four
+	// pairs for it, which DISTINCT collapses into a single shared feature
+	mock.ExpectQuery(`SELECT DISTINCT f.id, f.title.*FROM votes mine\s+JOIN votes theirs`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at"}).
+			AddRow(4, "Dark mode", "Desc", 3, "carol", 9, now, now))
+	mock.ExpectQuery(`SELECT COUNT\(DISTINCT v.feature_id\) FROM votes v.*SELECT COUNT\(DISTINCT v.feature_id\) FROM votes v`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"only_user", "only_other"}).AddRow(0, 1))
+
+	overlap, err := repo.GetVoteOverlap(1, 2)
+
+	require.NoError(t, err)
+	require.Len(t, overlap.SharedFeatures, 1)
+	assert.Equal(t, 1, overlap.SharedCount)
+	assert.Equal(t, 0, overlap.OnlyUserCount)
+	assert.Equal(t, 1, overlap.OnlyOtherCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetStaleFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	cutoff := time.Date(2026, 9, 15, 12, 0, 0, 0, time.UTC)
+	created := cutoff.AddDate(0, -2, 0)
+	username := "testuser"
+
+	mock.ExpectQuery(`SELECT f.id, f.title.*FROM features f\s+LEFT JOIN users u ON f.created_by = u.id\s+WHERE f.expired = FALSE AND f.status = 'open' AND f.created_at <= \$1 AND f.vote_count <= \$2\s+AND f.deleted_at IS NULL\s+ORDER BY f.vote_count ASC, f.created_at ASC`).
+		WithArgs(cutoff, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status"}).
+			AddRow(4, "Old idea", "Nobody voted for this", 1, username, 0, created, created, nil, false, "open").
+			AddRow(2, "Older idea", "Only a couple of votes", 1, username, 2, created.AddDate(0, -1, 0), created, nil, false, "open"))
+
+	stale, err := repo.GetStaleFeatures(cutoff, 2)
+
+	assert.NoError(t, err)
+	require.Len(t, stale, 2)
+	assert.Equal(t, 4, stale[0].ID)
+	assert.Equal(t, 2, stale[1].VoteCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetCoVotedFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	columns := []string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "co_voters"}
+
+	mock.ExpectQuery(`COUNT\(DISTINCT other.user_id\) as co_voters\s+FROM votes src\s+JOIN votes other ON other.user_id = src.user_id AND other.feature_id <> src.feature_id AND other.value = 1.*WHERE src.feature_id = \$1 AND src.value = 1 AND f.deleted_at IS NULL\s+GROUP BY f.id, u.username\s+ORDER BY co_voters DESC.*LIMIT \$2`).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(4, "Dark mode", "Desc", 2, "bob", 9, now, now, 5).
+			AddRow(2, "Export to CSV", "Desc", 3, "carol", 20, now, now, 2))
+
+	coVoted, err := repo.GetCoVotedFeatures(1, 10)
+
+	assert.NoError(t, err)
+	require.Len(t, coVoted, 2)
+	assert.Equal(t, 4, coVoted[0].ID)
+	assert.Equal(t, 5, coVoted[0].CoVoterCount)
+	assert.Equal(t, 2, coVoted[1].CoVoterCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetVoterPercentile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	query := `SELECT vote_total, percentile\s+FROM \(\s+SELECT u.id, COUNT\(v.id\) AS vote_total,\s+PERCENT_RANK\(\) OVER \(ORDER BY COUNT\(v.id\)\) \* 100 AS percentile`
+
+	t.Run("voter", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"vote_total", "percentile"}).AddRow(5, 75.0))
+
+		percentile, err := repo.GetVoterPercentile(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 75.0, percentile)
+	})
+
+	t.Run("user without votes", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"vote_total", "percentile"}).AddRow(0, 0.0))
+
+		percentile, err := repo.GetVoterPercentile(2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, percentile)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetVoterPercentile(99)
+
+		assert.EqualError(t, err, "user not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetByCreatedBy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT f.id, f.title, f.description, f.created_by, u.username, f.vote_count, f.created_at, f.updated_at, f.status, CASE WHEN v.id IS NOT NULL THEN true ELSE false END as has_user_voted, ARRAY\(.+\) AS tags FROM features f LEFT JOIN users u ON f.created_by = u.id LEFT JOIN votes v ON v.feature_id = f.id AND v.user_id = \$1 AND v.category = 'default' WHERE f.created_by = \$1 AND f.deleted_at IS NULL ORDER BY f.created_at DESC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "status", "has_user_voted", "tags"}).
+			AddRow(1, "Feature 1", "Description 1", 1, "user1", 3, now, now, "planned", true, "{mobile,ux}").
+			AddRow(2, "Feature 2", "Description 2", 1, "user1", 0, now, now, "open", false, "{}"))
+
+	result, err := repo.GetByCreatedBy(1)
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.True(t, result[0].HasUserVoted)
+	assert.Equal(t, "planned", result[0].Status)
+	assert.Equal(t, []string{"mobile", "ux"}, result[0].Tags)
+	assert.False(t, result[1].HasUserVoted)
+	assert.Empty(t, result[1].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetSurgingFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	columns := []string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "recent_votes", "baseline_daily_votes"}
+
+	mock.ExpectQuery(`COALESCE\(SUM\(v.value\) FILTER \(WHERE v.created_at >= NOW\(\) - INTERVAL '24 hours'\), 0\) AS recent_votes.*` +
+		`COALESCE\(SUM\(v.value\) FILTER \(WHERE v.created_at < NOW\(\) - INTERVAL '24 hours'\), 0\)::float.*` +
+		`WHERE f.deleted_at IS NULL\s+GROUP BY f.id.*` +
+		`WHERE w.recent_votes > 0 AND w.recent_votes > \$1 \* w.baseline_daily_votes.*LIMIT \$2`).
+		WithArgs(2.0, 5).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "Dark mode", "Desc", 1, "alice", 40, now, now, 9, 1.5).
+			AddRow(2, "Export", "Desc", 2, "bob", 3, now, now, 2, 0.0))
+
+	surging, err := repo.GetSurgingFeatures(2.0, 5)
+
+	require.NoError(t, err)
+	require.Len(t, surging, 2)
+	assert.Equal(t, 1, surging[0].ID)
+	assert.Equal(t, 9, surging[0].RecentVoteCount)
+	assert.Equal(t, 1.5, surging[0].BaselineDailyVotes)
+	assert.Equal(t, 2, surging[1].RecentVoteCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_SearchFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+	columns := []string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status", "has_user_voted", "tags"}
+
+	t.Run("matches title or description with vote status", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features f WHERE f.deleted_at IS NULL AND \(f.title ILIKE \$1 OR f.description ILIKE \$1\)`).
+			WithArgs("%dark%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`has_user_voted, ARRAY\(.+\) AS tags\s+FROM features f.*LEFT JOIN votes v ON v.feature_id = f.id AND v.user_id = \$4 AND v.category = 'default'\s+WHERE f.deleted_at IS NULL AND \(f.title ILIKE \$1 OR f.description ILIKE \$1\).*LIMIT \$2 OFFSET \$3`).
+			WithArgs("%dark%", 10, 0, 3).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, "Dark mode", "Desc", 1, "alice", 4, now, now, nil, false, "open", true, "{ux}"))
+
+		result, total, err := repo.SearchFeatures("dark", 1, 10, intPtr(3))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, result, 1)
+		assert.Equal(t, "Dark mode", result[0].Title)
+		assert.True(t, result[0].HasUserVoted)
+		assert.Equal(t, []string{"ux"}, result[0].Tags)
+	})
+
+	t.Run("wildcards in the query match literally", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features f`).
+			WithArgs(`%100\%\_done%`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(`WHERE f.deleted_at IS NULL AND \(f.title ILIKE \$1 OR f.description ILIKE \$1\)`).
+			WithArgs(`%100\%\_done%`, 10, 10, nil).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		result, total, err := repo.SearchFeatures("100%_done", 2, 10, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, total)
+		assert.Empty(t, result)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_UpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	t.Run("status updated", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE features SET status = \$1 WHERE id = \$2`).
+			WithArgs("planned", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateStatus(1, "planned"))
+	})
+
+	t.Run("feature not found", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE features SET status = \$1 WHERE id = \$2`).
+			WithArgs("rejected", 999).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.EqualError(t, repo.UpdateStatus(999, "rejected"), "feature not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetCategoryCounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	mock.ExpectQuery(`SELECT category, COUNT\(\*\) FROM votes WHERE feature_id = \$1 GROUP BY category`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "count"}).
+			AddRow("default", 4).
+			AddRow("would_pay", 2))
+
+	counts, err := repo.GetCategoryCounts(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"default": 4, "would_pay": 2}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetVoteRemovalImpact(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	existsQuery := `SELECT EXISTS\(SELECT 1 FROM users WHERE id = \$1\)`
+
+	t.Run("projects counts and milestones crossed", func(t *testing.T) {
+		mock.ExpectQuery(existsQuery).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery(`SELECT f.id, f.title, f.vote_count, COUNT\(v.id\) AS votes_removed, f.vote_count - SUM\(v.value\) AS projected_vote_count FROM votes v JOIN features f ON f.id = v.feature_id AND f.deleted_at IS NULL WHERE v.user_id = \$1 GROUP BY f.id, f.title, f.vote_count`).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "vote_count", "votes_removed", "projected_vote_count"}).
+				AddRow(1, "Dark mode", 25, 2, 23).
+				AddRow(2, "Export", 10, 1, 9).
+				AddRow(3, "SSO", 40, 1, 39))
+
+		impact, err := repo.GetVoteRemovalImpact(7)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, impact.UserID)
+		assert.Equal(t, 4, impact.TotalVotes)
+		assert.Equal(t, 2, impact.DroppedCount)
+		require.Len(t, impact.Features, 3)
+		assert.Equal(t, []int{25}, impact.Features[0].DropsBelow)
+		assert.Equal(t, 23, impact.Features[0].ProjectedVoteCount)
+		assert.Equal(t, []int{10}, impact.Features[1].DropsBelow)
+		assert.Nil(t, impact.Features[2].DropsBelow)
+	})
+
+	t.Run("user without votes", func(t *testing.T) {
+		mock.ExpectQuery(existsQuery).
+			WithArgs(8).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery(`FROM votes v JOIN features f`).
+			WithArgs(8).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "vote_count", "votes_removed", "projected_vote_count"}))
+
+		impact, err := repo.GetVoteRemovalImpact(8)
+
+		require.NoError(t, err)
+		assert.Empty(t, impact.Features)
+		assert.Equal(t, 0, impact.TotalVotes)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mock.ExpectQuery(existsQuery).
+			WithArgs(99).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		_, err := repo.GetVoteRemovalImpact(99)
+
+		assert.EqualError(t, err, "user not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	t.Run("marks the feature deleted and keeps its votes", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE features SET deleted_at = NOW\(\) WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Delete(1))
+	})
+
+	t.Run("missing or already deleted feature", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE features SET deleted_at = NOW\(\) WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.EqualError(t, repo.Delete(2), "feature not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_Restore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	t.Run("clears deleted_at", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE features SET deleted_at = NULL WHERE id = \$1 AND deleted_at IS NOT NULL`).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Restore(1))
+	})
+
+	t.Run("feature that is not deleted", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE features SET deleted_at = NULL WHERE id = \$1 AND deleted_at IS NOT NULL`).
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.EqualError(t, repo.Restore(2), "feature not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_AddTag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO tags \(name\) VALUES \(\$1\) ON CONFLICT \(name\) DO UPDATE SET name = EXCLUDED.name RETURNING id`).
+		WithArgs("mobile").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(`INSERT INTO feature_tags \(feature_id, tag_id\) VALUES \(\$1, \$2\) ON CONFLICT \(feature_id, tag_id\) DO NOTHING`).
+		WithArgs(1, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.AddTag(1, "mobile"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_RemoveTag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	t.Run("removes the tag", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM feature_tags WHERE feature_id = \$1 AND tag_id = \(SELECT id FROM tags WHERE name = \$2\)`).
+			WithArgs(1, "mobile").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.RemoveTag(1, "mobile"))
+	})
+
+	t.Run("tag the feature does not have", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM feature_tags`).
+			WithArgs(1, "desktop").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.EqualError(t, repo.RemoveTag(1, "desktop"), "tag not found")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetTags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	mock.ExpectQuery(`SELECT t.name FROM feature_tags ft JOIN tags t ON t.id = ft.tag_id WHERE ft.feature_id = \$1 ORDER BY t.name`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("mobile").AddRow("ux"))
+
+	tags, err := repo.GetTags(1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mobile", "ux"}, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_RecalculateVoteCounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+
+	mock.ExpectExec(`UPDATE features f SET vote_count = actual.total FROM \(\s*SELECT f2.id, COALESCE\(SUM\(v.value\), 0\) AS total FROM features f2 LEFT JOIN votes v ON v.feature_id = f2.id GROUP BY f2.id\s*\) actual WHERE f.id = actual.id AND f.vote_count <> actual.total`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	corrected, err := repo.RecalculateVoteCounts()
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, corrected)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_DerivedQueriesExcludeDeletedFeatures(t *testing.T) {
+	since := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name  string
+		setup func(sqlmock.Sqlmock)
+		call  func(*FeatureRepository) error
+	}{
+		{
+			name: "surging",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM features f\s+LEFT JOIN votes v ON v.feature_id = f.id\s+WHERE f.deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetSurgingFeatures(2, 5); return err },
+		},
+		{
+			name: "team picks",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT team_id FROM users WHERE id = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(7))
+				mock.ExpectQuery(`JOIN users tm ON .*WHERE f.deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetTeamPicks(1, 5); return err },
+		},
+		{
+			name: "also voted",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE src.feature_id = \$1 AND src.value = 1 AND f.deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetCoVotedFeatures(1, 5); return err },
+		},
+		{
+			name: "vote overlap",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE mine.user_id = \$1 AND f.deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetVoteOverlap(1, 2); return err },
+		},
+		{
+			name: "needs attention",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`f.vote_count <= \$2\s+AND f.deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetStaleFeatures(since, 2); return err },
+		},
+		{
+			name: "user stats",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM features\s+WHERE created_by = \$1 AND deleted_at IS NULL\s+GROUP BY created_by`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetUserStats(1); return err },
+		},
+		{
+			name: "votes received",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM features WHERE created_by = \$1 AND deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.CountVotesReceived(1); return err },
+		},
+		{
+			name: "changes since",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`created_by <> \$1 AND deleted_at IS NULL\).*AND f.deleted_at IS NULL\)`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetChangesSince(1, since); return err },
+		},
+		{
+			name: "rank snapshots",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`SELECT CURRENT_DATE, id, vote_count FROM features WHERE deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.SnapshotVoteCounts(); return err },
+		},
+		{
+			name: "feature voters",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`JOIN features f ON f.id = v.feature_id AND f.deleted_at IS NULL\s+WHERE v.feature_id = \$1`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetVotersByFeature(1, 10); return err },
+		},
+		{
+			name: "vote removal impact",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM users WHERE id = \$1\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery(`JOIN features f ON f.id = v.feature_id AND f.deleted_at IS NULL\s+WHERE v.user_id = \$1`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			call: func(r *FeatureRepository) error { _, err := r.GetVoteRemovalImpact(1); return err },
+		},
+		{
+			name: "vote on deleted feature",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`FROM features\s+WHERE id = \$1 AND deleted_at IS NULL`).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			call: func(r *FeatureRepository) error {
+				_, err := r.AddVote(1, 1, votes.DefaultCategory, votes.Upvote, nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.setup(mock)
+
+			err = tt.call(NewFeatureRepository(&DB{db}))
+
+			assert.ErrorIs(t, err, sql.ErrConnDone)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeatureRepository_GetByIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`END as has_user_voted, ARRAY\(.+\) AS tags\s+FROM features f.*WHERE f.id = ANY\(\$1\) AND f.deleted_at IS NULL`).
+		WithArgs("{1,2}", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status", "has_user_voted", "tags"}).
+			AddRow(1, "Dark mode", "Desc", 1, "alice", 4, now, now, nil, false, "open", true, "{ux}").
+			AddRow(2, "Export", "Desc", 2, "bob", 1, now, now, nil, false, "open", false, "{}"))
+
+	result, err := repo.GetByIDs([]int{1, 2}, intPtr(3))
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, []string{"ux"}, result[0].Tags)
+	assert.True(t, result[0].HasUserVoted)
+	assert.Empty(t, result[1].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeatureRepository_GetVotable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFeatureRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM features f\s+WHERE f.deleted_at IS NULL AND NOT f.expired AND \(f.expires_at IS NULL OR f.expires_at > CURRENT_TIMESTAMP\) AND f.status NOT IN \('completed', 'rejected'\)\s+AND NOT EXISTS`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`f.updated_at, f.expires_at, f.expired, f.status, ARRAY\(.+\) AS tags\s+FROM features f.*WHERE f.deleted_at IS NULL AND NOT f.expired AND \(f.expires_at IS NULL OR f.expires_at > CURRENT_TIMESTAMP\) AND f.status NOT IN \('completed', 'rejected'\)\s+AND NOT EXISTS .*LIMIT \$2 OFFSET \$3`).
+		WithArgs(3, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "expires_at", "expired", "status", "tags"}).
+			AddRow(1, "Dark mode", "Desc", 1, "alice", 4, now, now, now.Add(time.Hour), false, "open", "{mobile,ux}"))
+
+	result, total, err := repo.GetVotable(3, 1, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, result, 1)
+	assert.Equal(t, []string{"mobile", "ux"}, result[0].Tags)
+	assert.Equal(t, "open", result[0].Status)
+	require.NotNil(t, result[0].ExpiresAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/notifications"
+)
+
+// NotificationPreferenceRepository implements notifications.PreferencesRepository
+type NotificationPreferenceRepository struct {
+	db *DB
+}
+
+// NewNotificationPreferenceRepository creates a new notification preference repository
+func NewNotificationPreferenceRepository(db *DB) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{db: db}
+}
+
+// GetPreferences retrieves a user's stored notification preferences
+func (r *NotificationPreferenceRepository) GetPreferences(userID int) (*notifications.Preferences, error) {
+	prefs := &notifications.Preferences{}
+	query := `
+		SELECT user_id, vote_on_my_feature, status_change, subscription_updates, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+
+	err := r.db.QueryRow(query, userID).Scan(
+		&prefs.UserID, &prefs.VoteOnMyFeature, &prefs.StatusChange,
+		&prefs.SubscriptionUpdates, &prefs.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("preferences not found")
+		}
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// UpsertPreferences stores a user's notification preferences, replacing any existing row
+func (r *NotificationPreferenceRepository) UpsertPreferences(prefs *notifications.Preferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, vote_on_my_feature, status_change, subscription_updates)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			vote_on_my_feature = EXCLUDED.vote_on_my_feature,
+			status_change = EXCLUDED.status_change,
+			subscription_updates = EXCLUDED.subscription_updates,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(query, prefs.UserID, prefs.VoteOnMyFeature, prefs.StatusChange, prefs.SubscriptionUpdates).
+		Scan(&prefs.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return nil
+}
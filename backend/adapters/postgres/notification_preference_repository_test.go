@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/feature-voting-platform/backend/domain/notifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreferenceRepository_UpsertPreferences(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationPreferenceRepository(&DB{db})
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		prefs   *notifications.Preferences
+		setup   func()
+		wantErr bool
+	}{
+		{
+			name:  "inserts or replaces the user's row",
+			prefs: &notifications.Preferences{UserID: 1, VoteOnMyFeature: false, StatusChange: true, SubscriptionUpdates: true},
+			setup: func() {
+				mock.ExpectQuery(`INSERT INTO notification_preferences .* ON CONFLICT \(user_id\) DO UPDATE SET`).
+					WithArgs(1, false, true, true).
+					WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(now))
+			},
+		},
+		{
+			name:  "database error",
+			prefs: &notifications.Preferences{UserID: 1},
+			setup: func() {
+				mock.ExpectQuery(`INSERT INTO notification_preferences`).
+					WithArgs(1, false, false, false).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := repo.UpsertPreferences(tt.prefs)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				require.NotNil(t, tt.prefs.UpdatedAt)
+				assert.Equal(t, now, *tt.prefs.UpdatedAt)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationPreferenceRepository_GetPreferences_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationPreferenceRepository(&DB{db})
+
+	mock.ExpectQuery(`SELECT user_id, vote_on_my_feature, status_change, subscription_updates, updated_at FROM notification_preferences WHERE user_id = \$1`).
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+
+	prefs, err := repo.GetPreferences(1)
+
+	assert.Nil(t, prefs)
+	assert.EqualError(t, err, "preferences not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/reports"
+)
+
+// ReportRepository implements reports.Repository
+type ReportRepository struct {
+	db *DB
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(db *DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// Create records a user's report of a feature; a second report of the same feature by the
+// same user fails with "feature already reported"
+func (r *ReportRepository) Create(featureID, reporterID int, reason string) (*reports.Report, error) {
+	query := `
+		INSERT INTO reports (feature_id, reporter_id, reason)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (feature_id, reporter_id) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	report := &reports.Report{
+		FeatureID:  featureID,
+		ReporterID: reporterID,
+		Reason:     reason,
+	}
+	err := r.db.QueryRow(query, featureID, reporterID, reason).Scan(&report.ID, &report.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feature already reported")
+		}
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return report, nil
+}
+
+// GetByFeature returns a feature's reports, newest first
+func (r *ReportRepository) GetByFeature(featureID int) ([]reports.Report, error) {
+	query := `
+		SELECT id, feature_id, reporter_id, reason, created_at
+		FROM reports
+		WHERE feature_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	return r.query(query, featureID)
+}
+
+// GetAll returns every report, newest first
+func (r *ReportRepository) GetAll() ([]reports.Report, error) {
+	query := `
+		SELECT id, feature_id, reporter_id, reason, created_at
+		FROM reports
+		ORDER BY created_at DESC, id DESC
+	`
+
+	return r.query(query)
+}
+
+func (r *ReportRepository) query(query string, args ...interface{}) ([]reports.Report, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reports: %w", err)
+	}
+	defer rows.Close()
+
+	reportList := []reports.Report{}
+	for rows.Next() {
+		var report reports.Report
+		err := rows.Scan(&report.ID, &report.FeatureID, &report.ReporterID, &report.Reason, &report.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report: %w", err)
+		}
+		reportList = append(reportList, report)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reports: %w", err)
+	}
+
+	return reportList, nil
+}
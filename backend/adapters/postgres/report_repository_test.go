@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/feature-voting-platform/backend/domain/reports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReportRepository(&DB{db})
+	now := time.Now()
+
+	t.Run("stores the report", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO reports \(feature_id, reporter_id, reason\) VALUES \(\$1, \$2, \$3\) ON CONFLICT \(feature_id, reporter_id\) DO NOTHING RETURNING id, created_at`).
+			WithArgs(1, 2, "spam").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, now))
+
+		report, err := repo.Create(1, 2, "spam")
+
+		require.NoError(t, err)
+		assert.Equal(t, &reports.Report{ID: 10, FeatureID: 1, ReporterID: 2, Reason: "spam", CreatedAt: now}, report)
+	})
+
+	t.Run("feature already reported by the user", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO reports`).
+			WithArgs(1, 2, "abusive").
+			WillReturnError(sql.ErrNoRows)
+
+		report, err := repo.Create(1, 2, "abusive")
+
+		assert.EqualError(t, err, "feature already reported")
+		assert.Nil(t, report)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportRepository_GetByFeature(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReportRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT id, feature_id, reporter_id, reason, created_at FROM reports WHERE feature_id = \$1 ORDER BY created_at DESC, id DESC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id", "reporter_id", "reason", "created_at"}).
+			AddRow(2, 1, 3, "abusive", now).
+			AddRow(1, 1, 2, "spam", now.Add(-time.Hour)))
+
+	list, err := repo.GetByFeature(1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []reports.Report{
+		{ID: 2, FeatureID: 1, ReporterID: 3, Reason: "abusive", CreatedAt: now},
+		{ID: 1, FeatureID: 1, ReporterID: 2, Reason: "spam", CreatedAt: now.Add(-time.Hour)},
+	}, list)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewReportRepository(&DB{db})
+
+	mock.ExpectQuery(`SELECT id, feature_id, reporter_id, reason, created_at FROM reports ORDER BY created_at DESC, id DESC`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id", "reporter_id", "reason", "created_at"}))
+
+	list, err := repo.GetAll()
+
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	assert.NotNil(t, list)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
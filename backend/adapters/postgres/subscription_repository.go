@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/feature-voting-platform/backend/domain/features"
+)
+
+// SubscriptionRepository implements subscriptions.Repository
+type SubscriptionRepository struct {
+	db *DB
+}
+
+// NewSubscriptionRepository creates a new subscription repository
+func NewSubscriptionRepository(db *DB) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db}
+}
+
+// Subscribe subscribes a user to a feature; subscribing twice is a no-op
+func (r *SubscriptionRepository) Subscribe(userID, featureID int) error {
+	query := `
+		INSERT INTO feature_subscriptions (user_id, feature_id) VALUES ($1, $2)
+		ON CONFLICT (user_id, feature_id) DO NOTHING
+	`
+
+	_, err := r.db.Exec(query, userID, featureID)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to feature: %w", err)
+	}
+
+	return nil
+}
+
+// Unsubscribe removes a user's subscription to a feature
+func (r *SubscriptionRepository) Unsubscribe(userID, featureID int) error {
+	query := `DELETE FROM feature_subscriptions WHERE user_id = $1 AND feature_id = $2`
+
+	result, err := r.db.Exec(query, userID, featureID)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe from feature: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("subscription not found")
+	}
+
+	return nil
+}
+
+// GetSubscribedFeatures retrieves the features a user is subscribed to, newest subscription first
+func (r *SubscriptionRepository) GetSubscribedFeatures(userID, page, perPage int) ([]features.Feature, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM feature_subscriptions WHERE user_id = $1`
+	err := r.db.QueryRow(countQuery, userID).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get subscribed features count: %w", err)
+	}
+
+	query := `
+		SELECT f.id, f.title, f.description, f.created_by, u.username,
+		       f.vote_count, f.created_at, f.updated_at,
+		       EXISTS(SELECT 1 FROM votes v WHERE v.feature_id = f.id AND v.user_id = $1) as has_user_voted
+		FROM feature_subscriptions s
+		JOIN features f ON s.feature_id = f.id
+		LEFT JOIN users u ON f.created_by = u.id
+		WHERE s.user_id = $1
+		ORDER BY s.created_at DESC, s.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(query, userID, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get subscribed features: %w", err)
+	}
+	defer rows.Close()
+
+	var featuresList []features.Feature
+	for rows.Next() {
+		var feature features.Feature
+		err := rows.Scan(
+			&feature.ID, &feature.Title, &feature.Description, &feature.CreatedBy,
+			&feature.CreatedByUser, &feature.VoteCount, &feature.CreatedAt, &feature.UpdatedAt,
+			&feature.HasUserVoted,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan feature: %w", err)
+		}
+		featuresList = append(featuresList, feature)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating features: %w", err)
+	}
+
+	return featuresList, total, nil
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionRepository_GetSubscribedFeatures(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSubscriptionRepository(&DB{db})
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		userID    int
+		page      int
+		perPage   int
+		setup     func()
+		wantCount int
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name:    "joins features in subscription order",
+			userID:  1,
+			page:    2,
+			perPage: 2,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM feature_subscriptions WHERE user_id = \$1`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "created_by", "username", "vote_count", "created_at", "updated_at", "has_user_voted"}).
+					AddRow(7, "Dark mode", "Dark theme", 2, "alice", 5, now, now, true)
+				mock.ExpectQuery(`FROM feature_subscriptions s\s+JOIN features f ON s.feature_id = f.id.*WHERE s.user_id = \$1\s+ORDER BY s.created_at DESC, s.id DESC\s+LIMIT \$2 OFFSET \$3`).
+					WithArgs(1, 2, 2).
+					WillReturnRows(rows)
+			},
+			wantCount: 1,
+			wantTotal: 3,
+		},
+		{
+			name:    "count query error",
+			userID:  1,
+			page:    1,
+			perPage: 10,
+			setup: func() {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM feature_subscriptions`).
+					WithArgs(1).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, total, err := repo.GetSubscribedFeatures(tt.userID, tt.page, tt.perPage)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.wantCount)
+				assert.Equal(t, tt.wantTotal, total)
+				assert.Equal(t, "Dark mode", result[0].Title)
+				assert.True(t, result[0].HasUserVoted)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSubscriptionRepository_Unsubscribe_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSubscriptionRepository(&DB{db})
+
+	mock.ExpectExec(`DELETE FROM feature_subscriptions WHERE user_id = \$1 AND feature_id = \$2`).
+		WithArgs(1, 9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Unsubscribe(1, 9)
+
+	assert.EqualError(t, err, "subscription not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
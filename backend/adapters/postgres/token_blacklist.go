@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+)
+
+// TokenBlacklist implements auth.TokenBlacklist on the revoked_tokens table, so revocations
+// survive restarts and apply to every instance
+type TokenBlacklist struct {
+	db *DB
+}
+
+// NewTokenBlacklist creates a new postgres-backed token blacklist
+func NewTokenBlacklist(db *DB) *TokenBlacklist {
+	return &TokenBlacklist{db: db}
+}
+
+// Revoke blacklists the token until exp, first deleting entries whose tokens have expired
+func (b *TokenBlacklist) Revoke(jti string, exp time.Time) error {
+	if _, err := b.db.Exec(`DELETE FROM revoked_tokens WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("failed to purge expired revoked tokens: %w", err)
+	}
+
+	query := `
+		INSERT INTO revoked_tokens (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+
+	if _, err := b.db.Exec(query, jti, exp); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
+
+// IsRevoked reports whether the token ID was revoked and has not yet expired
+func (b *TokenBlacklist) IsRevoked(jti string) (bool, error) {
+	var revoked bool
+	query := `SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = $1 AND expires_at > NOW())`
+
+	err := b.db.QueryRow(query, jti).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("failed to check revoked token: %w", err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBlacklist_Revoke(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	blacklist := NewTokenBlacklist(&DB{db})
+	exp := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`DELETE FROM revoked_tokens WHERE expires_at <= NOW\(\)`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`INSERT INTO revoked_tokens \(jti, expires_at\)`).
+		WithArgs("abc123", exp).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, blacklist.Revoke("abc123", exp))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenBlacklist_IsRevoked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	blacklist := NewTokenBlacklist(&DB{db})
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "revoked token",
+			setup: func() {
+				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM revoked_tokens WHERE jti = \$1 AND expires_at > NOW\(\)\)`).
+					WithArgs("abc123").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			want: true,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM revoked_tokens`).
+					WithArgs("abc123").
+					WillReturnError(fmt.Errorf("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			revoked, err := blacklist.IsRevoked("abc123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, revoked)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
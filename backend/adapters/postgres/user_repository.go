@@ -3,10 +3,15 @@ package postgres
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/feature-voting-platform/backend/domain/users"
+	"github.com/lib/pq"
 )
 
+// uniqueViolation is the Postgres error code for a unique constraint failure
+const uniqueViolation = "23505"
+
 // UserRepository implements the users.Repository interface
 type UserRepository struct {
 	db *DB
@@ -20,15 +25,22 @@ func NewUserRepository(db *DB) *UserRepository {
 // Create creates a new user in the database
 func (r *UserRepository) Create(user *users.User) error {
 	query := `
-		INSERT INTO users (username, email, password_hash)
-		VALUES ($1, $2, $3)
+		INSERT INTO users (username, email, password_hash, role, email_verified)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id, created_at, updated_at
 	`
+	if user.Role == "" {
+		user.Role = users.RoleUser
+	}
 	
-	err := r.db.QueryRow(query, user.Username, user.Email, user.PasswordHash).
+	err := r.db.QueryRow(query, user.Username, user.Email, user.PasswordHash, user.Role, user.EmailVerified).
 		Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
 	
 	if err != nil {
+		// Two sign-ups can pass the existence checks at once; the unique index decides
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == uniqueViolation {
+			return fmt.Errorf("user already exists")
+		}
 		return fmt.Errorf("failed to create user: %w", err)
 	}
 	
@@ -39,13 +51,13 @@ func (r *UserRepository) Create(user *users.User) error {
 func (r *UserRepository) GetByEmail(email string) (*users.User, error) {
 	user := &users.User{}
 	query := `
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
 	
 	err := r.db.QueryRow(query, email).Scan(
-		&user.ID, &user.Username, &user.Email, &user.PasswordHash,
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.EmailVerified, &user.PasswordHash,
 		&user.CreatedAt, &user.UpdatedAt,
 	)
 	
@@ -63,13 +75,13 @@ func (r *UserRepository) GetByEmail(email string) (*users.User, error) {
 func (r *UserRepository) GetByID(id int) (*users.User, error) {
 	user := &users.User{}
 	query := `
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
 	
 	err := r.db.QueryRow(query, id).Scan(
-		&user.ID, &user.Username, &user.Email, &user.PasswordHash,
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.EmailVerified, &user.PasswordHash,
 		&user.CreatedAt, &user.UpdatedAt,
 	)
 	
@@ -87,13 +99,13 @@ func (r *UserRepository) GetByID(id int) (*users.User, error) {
 func (r *UserRepository) GetByUsername(username string) (*users.User, error) {
 	user := &users.User{}
 	query := `
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at
 		FROM users
 		WHERE username = $1
 	`
 	
 	err := r.db.QueryRow(query, username).Scan(
-		&user.ID, &user.Username, &user.Email, &user.PasswordHash,
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.EmailVerified, &user.PasswordHash,
 		&user.CreatedAt, &user.UpdatedAt,
 	)
 	
@@ -107,6 +119,40 @@ func (r *UserRepository) GetByUsername(username string) (*users.User, error) {
 	return user, nil
 }
 
+// GetAll retrieves every user ordered by ID
+func (r *UserRepository) GetAll() ([]users.User, error) {
+	query := `
+		SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at
+		FROM users
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	defer rows.Close()
+
+	var list []users.User
+	for rows.Next() {
+		var user users.User
+		err := rows.Scan(
+			&user.ID, &user.Username, &user.Email, &user.Role, &user.EmailVerified, &user.PasswordHash,
+			&user.CreatedAt, &user.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		list = append(list, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return list, nil
+}
+
 // Update updates a user in the database
 func (r *UserRepository) Update(user *users.User) error {
 	query := `
@@ -147,6 +193,48 @@ func (r *UserRepository) Delete(id int) error {
 	return nil
 }
 
+// RecordLogin stamps a successful login, keeping the one before it as previous_login_at
+func (r *UserRepository) RecordLogin(id int) error {
+	query := `
+		UPDATE users
+		SET previous_login_at = last_login_at, last_login_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return fmt.Errorf("failed to record login: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+// GetPreviousLoginAt returns when the user logged in before their current session; nil on
+// their first login
+func (r *UserRepository) GetPreviousLoginAt(id int) (*time.Time, error) {
+	var previous *time.Time
+	query := `SELECT previous_login_at FROM users WHERE id = $1`
+
+	err := r.db.QueryRow(query, id).Scan(&previous)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get previous login: %w", err)
+	}
+
+	return previous, nil
+}
+
 // EmailExists checks if an email already exists
 func (r *UserRepository) EmailExists(email string) (bool, error) {
 	var exists bool
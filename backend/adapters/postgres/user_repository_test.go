@@ -34,7 +34,7 @@ func TestUserRepository_Create(t *testing.T) {
 			},
 			setup: func() {
 				mock.ExpectQuery(`INSERT INTO users`).
-					WithArgs("testuser", "test@example.com", "hashed_password").
+					WithArgs("testuser", "test@example.com", "hashed_password", "user", false).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
 						AddRow(1, now, now))
 			},
@@ -49,7 +49,7 @@ func TestUserRepository_Create(t *testing.T) {
 			},
 			setup: func() {
 				mock.ExpectQuery(`INSERT INTO users`).
-					WithArgs("testuser", "test@example.com", "hashed_password").
+					WithArgs("testuser", "test@example.com", "hashed_password", "user", false).
 					WillReturnError(sql.ErrConnDone)
 			},
 			wantErr: true,
@@ -95,18 +95,20 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 			name:  "user found",
 			email: "test@example.com",
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE email = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE email = \$1`).
 					WithArgs("test@example.com").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password_hash", "created_at", "updated_at"}).
-						AddRow(1, "testuser", "test@example.com", "hashed_password", now, now))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "email_verified", "password_hash", "created_at", "updated_at"}).
+						AddRow(1, "testuser", "test@example.com", "user", true, "hashed_password", now, now))
 			},
 			want: &users.User{
-				ID:           1,
-				Username:     "testuser",
-				Email:        "test@example.com",
-				PasswordHash: "hashed_password",
-				CreatedAt:    now,
-				UpdatedAt:    now,
+				ID:            1,
+				Username:      "testuser",
+				Email:         "test@example.com",
+				Role:          "user",
+				EmailVerified: true,
+				PasswordHash:  "hashed_password",
+				CreatedAt:     now,
+				UpdatedAt:     now,
 			},
 			wantErr: false,
 		},
@@ -114,7 +116,7 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 			name:  "user not found",
 			email: "nonexistent@example.com",
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE email = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE email = \$1`).
 					WithArgs("nonexistent@example.com").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -125,7 +127,7 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 			name:  "database error",
 			email: "test@example.com",
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE email = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE email = \$1`).
 					WithArgs("test@example.com").
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -172,18 +174,20 @@ func TestUserRepository_GetByID(t *testing.T) {
 			name: "user found",
 			id:   1,
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE id = \$1`).
 					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password_hash", "created_at", "updated_at"}).
-						AddRow(1, "testuser", "test@example.com", "hashed_password", now, now))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "email_verified", "password_hash", "created_at", "updated_at"}).
+						AddRow(1, "testuser", "test@example.com", "user", true, "hashed_password", now, now))
 			},
 			want: &users.User{
-				ID:           1,
-				Username:     "testuser",
-				Email:        "test@example.com",
-				PasswordHash: "hashed_password",
-				CreatedAt:    now,
-				UpdatedAt:    now,
+				ID:            1,
+				Username:      "testuser",
+				Email:         "test@example.com",
+				Role:          "user",
+				EmailVerified: true,
+				PasswordHash:  "hashed_password",
+				CreatedAt:     now,
+				UpdatedAt:     now,
 			},
 			wantErr: false,
 		},
@@ -191,7 +195,7 @@ func TestUserRepository_GetByID(t *testing.T) {
 			name: "user not found",
 			id:   999,
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE id = \$1`).
 					WithArgs(999).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -238,18 +242,20 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 			name:     "user found",
 			username: "testuser",
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE username = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE username = \$1`).
 					WithArgs("testuser").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password_hash", "created_at", "updated_at"}).
-						AddRow(1, "testuser", "test@example.com", "hashed_password", now, now))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "email_verified", "password_hash", "created_at", "updated_at"}).
+						AddRow(1, "testuser", "test@example.com", "user", true, "hashed_password", now, now))
 			},
 			want: &users.User{
-				ID:           1,
-				Username:     "testuser",
-				Email:        "test@example.com",
-				PasswordHash: "hashed_password",
-				CreatedAt:    now,
-				UpdatedAt:    now,
+				ID:            1,
+				Username:      "testuser",
+				Email:         "test@example.com",
+				Role:          "user",
+				EmailVerified: true,
+				PasswordHash:  "hashed_password",
+				CreatedAt:     now,
+				UpdatedAt:     now,
 			},
 			wantErr: false,
 		},
@@ -257,7 +263,7 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 			name:     "user not found",
 			username: "nonexistent",
 			setup: func() {
-				mock.ExpectQuery(`SELECT id, username, email, password_hash, created_at, updated_at FROM users WHERE username = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users WHERE username = \$1`).
 					WithArgs("nonexistent").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -285,6 +291,29 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 	}
 }
 
+func TestUserRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewUserRepository(&DB{db})
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT id, username, email, role, email_verified, password_hash, created_at, updated_at FROM users ORDER BY id`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "email_verified", "password_hash", "created_at", "updated_at"}).
+			AddRow(1, "admin", "admin@example.com", "admin", true, "hash1", now, now).
+			AddRow(2, "testuser", "test@example.com", "user", false, "hash2", now, now))
+
+	list, err := repo.GetAll()
+
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "admin", list[0].Username)
+	assert.Equal(t, 2, list[1].ID)
+	assert.False(t, list[1].EmailVerified)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserRepository_Update(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -409,4 +438,20 @@ func TestUserRepository_Delete(t *testing.T) {
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
-}
\ No newline at end of file
+}
+func TestUserRepository_RecordLogin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewUserRepository(&DB{db})
+
+	mock.ExpectExec(`UPDATE users\s+SET previous_login_at = last_login_at, last_login_at = CURRENT_TIMESTAMP\s+WHERE id = \$1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.RecordLogin(1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// VerificationTokenRepository implements the users.VerificationTokenRepository interface
+type VerificationTokenRepository struct {
+	db *DB
+}
+
+// NewVerificationTokenRepository creates a new verification token repository
+func NewVerificationTokenRepository(db *DB) *VerificationTokenRepository {
+	return &VerificationTokenRepository{db: db}
+}
+
+// Create stores a token hash for the user until expiresAt, first deleting expired tokens
+func (r *VerificationTokenRepository) Create(userID int, tokenHash string, expiresAt time.Time) error {
+	if _, err := r.db.Exec(`DELETE FROM email_verification_tokens WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("failed to purge expired verification tokens: %w", err)
+	}
+
+	query := `
+		INSERT INTO email_verification_tokens (token_hash, user_id, expires_at)
+		VALUES ($1, $2, $3)
+	`
+	if _, err := r.db.Exec(query, tokenHash, userID, expiresAt); err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	return nil
+}
+
+// Verify deletes the token and marks its user's email verified in one transaction, so a
+// token can only be used once
+func (r *VerificationTokenRepository) Verify(tokenHash string) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var userID int
+	err = tx.QueryRow(`
+		DELETE FROM email_verification_tokens
+		WHERE token_hash = $1 AND expires_at > NOW()
+		RETURNING user_id
+	`, tokenHash).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("verification token not found")
+		}
+		return 0, fmt.Errorf("failed to consume verification token: %w", err)
+	}
+
+	_, err = tx.Exec(`UPDATE users SET email_verified = TRUE, updated_at = CURRENT_TIMESTAMP WHERE id = $1`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark email verified: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificationTokenRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewVerificationTokenRepository(&DB{db})
+	exp := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`DELETE FROM email_verification_tokens WHERE expires_at <= NOW\(\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO email_verification_tokens \(token_hash, user_id, expires_at\)`).
+		WithArgs("hash", 7, exp).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Create(7, "hash", exp))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerificationTokenRepository_Verify(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(mock sqlmock.Sqlmock)
+		expectedID    int
+		expectedError string
+	}{
+		{
+			name: "valid token",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`DELETE FROM email_verification_tokens\s+WHERE token_hash = \$1 AND expires_at > NOW\(\)\s+RETURNING user_id`).
+					WithArgs("hash").
+					WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(7))
+				mock.ExpectExec(`UPDATE users SET email_verified = TRUE`).
+					WithArgs(7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedID: 7,
+		},
+		{
+			name: "unknown, used or expired token",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`DELETE FROM email_verification_tokens`).
+					WithArgs("hash").
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedError: "verification token not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.setup(mock)
+			userID, err := NewVerificationTokenRepository(&DB{db}).Verify("hash")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, userID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/activity"
+	"github.com/gin-gonic/gin"
+)
+
+// ActivityHandler handles activity stream HTTP requests
+type ActivityHandler struct {
+	activityRepo activity.Repository
+	logger       logs.Logger
+}
+
+// NewActivityHandler creates a new activity handler
+func NewActivityHandler(activityRepo activity.Repository, logger logs.Logger) *ActivityHandler {
+	return &ActivityHandler{
+		activityRepo: activityRepo,
+		logger:       logger,
+	}
+}
+
+// recordActivity appends an event to the activity stream when one is configured. The stream
+// is informational, so a failed write is logged and the request carries on.
+func recordActivity(c *gin.Context, repo activity.Repository, logger logs.Logger, event activity.Event) {
+	if repo == nil {
+		return
+	}
+
+	if err := repo.Record(event); err != nil {
+		logger.Error("Failed to record activity event", err,
+			logs.WithFeatureID(event.FeatureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithMetadata("event_type", event.Type))
+	}
+}
+
+// GetActivity godoc
+// @Summary Get the global activity stream
+// @Description Get a paginated, newest-first stream of feature creations and vote milestones
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} activity.EventListResponse "Activity events"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /activity [get]
+func (h *ActivityHandler) GetActivity(c *gin.Context) {
+	h.logger.Info("Get activity stream request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	page, perPage := parsePagination(c)
+
+	events, total, err := h.activityRepo.List(page, perPage)
+	if err != nil {
+		h.logger.Error("Failed to get activity events from database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get activity"})
+		return
+	}
+	if events == nil {
+		events = []activity.Event{}
+	}
+
+	h.logger.Info("Activity stream retrieved successfully",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("total_events", total),
+		logs.WithMetadata("returned_count", len(events)))
+
+	c.JSON(http.StatusOK, activity.EventListResponse{
+		Events:  events,
+		Total:   total,
+		Page:    page,
+		PerPage: perPage,
+	})
+}
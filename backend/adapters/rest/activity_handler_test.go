@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/activity"
+	activitymocks "github.com/feature-voting-platform/backend/domain/activity/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityHandler_GetActivity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Now()
+
+	repo := activitymocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewActivityHandler(repo, logger)
+
+	milestone := 50
+	repo.On("List", 1, 10).Return([]activity.Event{
+		{ID: 2, Type: activity.TypeVoteMilestone, ActorID: intPtr(3), FeatureID: 7, FeatureTitle: "Dark mode", Milestone: &milestone, CreatedAt: now},
+		{ID: 1, Type: activity.TypeFeatureCreated, ActorID: intPtr(1), ActorUsername: stringPtr("bob"), FeatureID: 7, FeatureTitle: "Dark mode", CreatedAt: now.Add(-time.Hour)},
+	}, 2, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.GET("/activity", handler.GetActivity)
+
+	req, _ := http.NewRequest(http.MethodGet, "/activity", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(2), response["total"])
+	events := response["events"].([]interface{})
+	require.Len(t, events, 2)
+
+	first := events[0].(map[string]interface{})
+	assert.Equal(t, activity.TypeVoteMilestone, first["type"])
+	assert.Equal(t, float64(50), first["milestone"])
+
+	second := events[1].(map[string]interface{})
+	assert.Equal(t, activity.TypeFeatureCreated, second["type"])
+	assert.Equal(t, "bob", second["actor_username"])
+	assert.NotContains(t, second, "milestone")
+}
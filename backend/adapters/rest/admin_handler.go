@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/admin"
+	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/gin-gonic/gin"
+)
+
+// AttentionDigestSource provides the latest needs-attention digest, and false until one
+// has been built
+type AttentionDigestSource interface {
+	Digest() (features.AttentionDigest, bool)
+}
+
+// AdminHandler handles moderation HTTP requests
+type AdminHandler struct {
+	digests AttentionDigestSource
+	stats   admin.Repository
+	logger  logs.Logger
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(digests AttentionDigestSource, logger logs.Logger) *AdminHandler {
+	return &AdminHandler{
+		digests: digests,
+		logger:  logger,
+	}
+}
+
+// WithStats sets the repository used for the consistency stats endpoint
+func (h *AdminHandler) WithStats(stats admin.Repository) *AdminHandler {
+	h.stats = stats
+	return h
+}
+
+// GetNeedsAttention godoc
+// @Summary Get features needing attention
+// @Description Get the latest digest of open features that are old and have few votes
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} features.AttentionDigest "Needs-attention digest"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 503 {object} map[string]interface{} "Digest not built yet"
+// @Router /admin/needs-attention [get]
+func (h *AdminHandler) GetNeedsAttention(c *gin.Context) {
+	digest, ok := h.digests.Digest()
+	if !ok {
+		h.logger.Warning("Needs-attention digest requested before it was built",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusServiceUnavailable))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Needs-attention digest not available yet"})
+		return
+	}
+
+	h.logger.Info("Needs-attention digest retrieved",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("feature_count", len(digest.Features)))
+
+	c.JSON(http.StatusOK, digest)
+}
+
+// GetStats godoc
+// @Summary Get consistency stats
+// @Description Get feature, vote and user counts, and features whose stored vote count does not match their votes
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} admin.Stats "Consistency stats"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /admin/stats [get]
+func (h *AdminHandler) GetStats(c *gin.Context) {
+	stats, err := h.stats.GetStats()
+	if err != nil {
+		h.logger.Error("Failed to get admin stats", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get stats"})
+		return
+	}
+
+	if len(stats.VoteCountMismatches) > 0 {
+		h.logger.Warning("Vote count mismatches detected",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithMetadata("mismatch_count", len(stats.VoteCountMismatches)))
+	}
+
+	h.logger.Info("Admin stats retrieved",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, stats)
+}
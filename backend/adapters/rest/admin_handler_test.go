@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/admin"
+	adminmocks "github.com/feature-voting-platform/backend/domain/admin/mocks"
+	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/feature-voting-platform/backend/domain/users"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDigestSource struct {
+	digest *features.AttentionDigest
+}
+
+func (s stubDigestSource) Digest() (features.AttentionDigest, bool) {
+	if s.digest == nil {
+		return features.AttentionDigest{}, false
+	}
+	return *s.digest, true
+}
+
+func TestAdminHandler_GetNeedsAttention(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		source         stubDigestSource
+		expectedStatus int
+		wantFeatures   int
+	}{
+		{
+			name: "returns the latest digest",
+			source: stubDigestSource{digest: &features.AttentionDigest{
+				MinAgeDays: 30,
+				MaxVotes:   2,
+				Features:   []features.Feature{{ID: 1}, {ID: 2}},
+			}},
+			expectedStatus: http.StatusOK,
+			wantFeatures:   2,
+		},
+		{
+			name:           "digest not built yet",
+			source:         stubDigestSource{},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logsmocks.NewMockLogger(t)
+			expectAnyLogs(logger)
+			handler := NewAdminHandler(tt.source, logger)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/admin/needs-attention", nil)
+
+			handler.GetNeedsAttention(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var digest features.AttentionDigest
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &digest))
+				assert.Len(t, digest.Features, tt.wantFeatures)
+				assert.Equal(t, 30, digest.MinAgeDays)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_GetNeedsAttention_RequiresAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		expectedStatus int
+	}{
+		{name: "admin gets the digest", role: users.RoleAdmin, expectedStatus: http.StatusOK},
+		{name: "regular user is forbidden", role: users.RoleUser, expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logsmocks.NewMockLogger(t)
+			expectAnyLogs(logger)
+			handler := NewAdminHandler(stubDigestSource{digest: &features.AttentionDigest{}}, logger)
+
+			router := gin.New()
+			router.Use(setUserID(1), setRole(tt.role), RequireRole(users.RoleAdmin))
+			router.GET("/admin/needs-attention", handler.GetNeedsAttention)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/admin/needs-attention", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestAdminHandler_GetStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		stats          *admin.Stats
+		repoErr        error
+		expectedStatus int
+		wantMismatches int
+	}{
+		{
+			name: "returns stats with mismatches",
+			stats: &admin.Stats{
+				Features: 3,
+				Votes:    5,
+				Users:    2,
+				VoteCountMismatches: []admin.VoteCountMismatch{
+					{FeatureID: 1, Title: "Dark mode", StoredCount: 4, ActualCount: 3},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			wantMismatches: 1,
+		},
+		{
+			name:           "returns stats without mismatches",
+			stats:          &admin.Stats{Features: 3, VoteCountMismatches: []admin.VoteCountMismatch{}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "repository error",
+			repoErr:        errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logsmocks.NewMockLogger(t)
+			expectAnyLogs(logger)
+			repo := adminmocks.NewMockRepository(t)
+			repo.EXPECT().GetStats().Return(tt.stats, tt.repoErr)
+			handler := NewAdminHandler(stubDigestSource{}, logger).WithStats(repo)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/admin/stats", nil)
+
+			handler.GetStats(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var body map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, float64(tt.stats.Features), body["features"])
+				mismatches, ok := body["vote_count_mismatches"].([]interface{})
+				require.True(t, ok)
+				assert.Len(t, mismatches, tt.wantMismatches)
+			}
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package rest
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/feature-voting-platform/backend/adapters/auth"
 	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
 	"github.com/feature-voting-platform/backend/domain/users"
+	"github.com/feature-voting-platform/backend/domain/votes"
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,6 +20,13 @@ type AuthHandler struct {
 	userRepo        users.Repository
 	tokenService    auth.TokenService
 	passwordService auth.PasswordService
+	emailChecker    *auth.DisposableEmailChecker
+	passwordPolicy  auth.PasswordPolicy
+	blacklist       auth.TokenBlacklist
+	featureRepo     features.Repository
+	voteRepo        votes.Repository
+	verifyTokens    users.VerificationTokenRepository
+	verifySender    auth.VerificationSender
 	logger          logs.Logger
 }
 
@@ -29,18 +41,316 @@ func NewAuthHandler(
 		userRepo:        userRepo,
 		tokenService:    tokenService,
 		passwordService: passwordService,
+		passwordPolicy:  auth.DefaultPasswordPolicy(),
 		logger:          logger,
 	}
 }
 
+// WithPasswordPolicy sets the rules passwords must satisfy at registration
+func (h *AuthHandler) WithPasswordPolicy(policy auth.PasswordPolicy) *AuthHandler {
+	h.passwordPolicy = policy
+	return h
+}
+
+// WithDisposableEmailChecker rejects registrations from the checker's blocked domains
+func (h *AuthHandler) WithDisposableEmailChecker(checker *auth.DisposableEmailChecker) *AuthHandler {
+	h.emailChecker = checker
+	return h
+}
+
+// WithTokenBlacklist enables logout by revoking tokens in the given blacklist
+func (h *AuthHandler) WithTokenBlacklist(blacklist auth.TokenBlacklist) *AuthHandler {
+	h.blacklist = blacklist
+	return h
+}
+
+// WithProfileStats adds the user's features created and votes cast to profile responses
+func (h *AuthHandler) WithProfileStats(featureRepo features.Repository, voteRepo votes.Repository) *AuthHandler {
+	h.featureRepo = featureRepo
+	h.voteRepo = voteRepo
+	return h
+}
+
+// WithEmailVerification issues a verification token to each newly registered user and enables
+// the verify endpoint
+func (h *AuthHandler) WithEmailVerification(tokens users.VerificationTokenRepository, sender auth.VerificationSender) *AuthHandler {
+	h.verifyTokens = tokens
+	h.verifySender = sender
+	return h
+}
+
+// Register godoc
+// @Summary Register user
+// @Description Create a new user account and return a JWT token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param user body users.CreateUserRequest true "New user details"
+// @Success 201 {object} map[string]interface{} "Registration successful with access and refresh tokens"
+// @Failure 400 {object} map[string]interface{} "Bad request, or a password failing the policy with one error per failed rule"
+// @Failure 409 {object} map[string]interface{} "Email or username already taken"
+// @Failure 422 {object} map[string]interface{} "Disposable email not allowed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/register [post]
+func (h *AuthHandler) Register(c *gin.Context) {
+	h.logger.Info("Registration attempt started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	var req users.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Registration request validation failed", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+
+	username := strings.TrimSpace(req.Username)
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if len(username) < 3 {
+		h.logger.Warning("Registration username too short after trimming",
+			logs.WithEmail(email),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Username must be between 3 and 50 characters"})
+		return
+	}
+
+	if failed := auth.ValidatePassword(req.Password, h.passwordPolicy); len(failed) > 0 {
+		h.logger.Warning("Registration password fails the password policy",
+			logs.WithEmail(email),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("failed_rules", len(failed)))
+		fieldErrors := make([]FieldError, 0, len(failed))
+		for _, rule := range failed {
+			fieldErrors = append(fieldErrors, FieldError{Field: "password", Message: rule})
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"errors": fieldErrors})
+		return
+	}
+
+	if h.emailChecker != nil {
+		if err := h.emailChecker.Check(email); err != nil {
+			h.logger.Warning("Registration with disposable email rejected",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithEmail(email),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusUnprocessableEntity))
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	emailTaken, err := h.userRepo.EmailExists(email)
+	if err != nil {
+		h.logger.Error("Failed to check if email exists", err,
+			logs.WithEmail(email),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		return
+	}
+	if emailTaken {
+		h.logger.Warning("Registration with existing email",
+			logs.WithEmail(email),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusConflict))
+		c.JSON(http.StatusConflict, gin.H{"error": "Email already registered"})
+		return
+	}
+
+	usernameTaken, err := h.userRepo.UsernameExists(username)
+	if err != nil {
+		h.logger.Error("Failed to check if username exists", err,
+			logs.WithUsername(username),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		return
+	}
+	if usernameTaken {
+		h.logger.Warning("Registration with existing username",
+			logs.WithUsername(username),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusConflict))
+		c.JSON(http.StatusConflict, gin.H{"error": "Username already taken"})
+		return
+	}
+
+	hashedPassword, err := h.passwordService.HashPassword(req.Password)
+	if err != nil {
+		h.logger.Error("Failed to hash password", err,
+			logs.WithEmail(email),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		return
+	}
+
+	user := &users.User{
+		Username:     username,
+		Email:        email,
+		PasswordHash: hashedPassword,
+	}
+	if err := h.userRepo.Create(user); err != nil {
+		if err.Error() == "user already exists" {
+			h.logger.Warning("Registration lost race for email or username",
+				logs.WithEmail(email),
+				logs.WithUsername(username),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusConflict))
+			c.JSON(http.StatusConflict, gin.H{"error": "Email or username already taken"})
+			return
+		}
+		h.logger.Error("Failed to create user in database", err,
+			logs.WithEmail(email),
+			logs.WithUsername(username),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
+		return
+	}
+
+	h.logger.Info("User registered successfully",
+		logs.WithCategory(logs.CategorySecurity),
+		logs.WithUserID(user.ID),
+		logs.WithUsername(user.Username),
+		logs.WithEmail(email),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusCreated))
+
+	if h.verifyTokens != nil {
+		h.issueVerificationToken(c, user)
+	}
+
+	response := gin.H{
+		"message": "Registration successful",
+		"user":    user.ToResponse(),
+	}
+
+	// The account exists either way; missing tokens only mean the client logs in separately
+	token, err := h.tokenService.GenerateToken(user.ID, user.Username, user.Email, user.Role)
+	if err == nil {
+		response["token"] = token
+		var refreshToken string
+		if refreshToken, err = h.tokenService.GenerateRefreshToken(user.ID); err == nil {
+			response["refresh_token"] = refreshToken
+		}
+	}
+	if err != nil {
+		h.logger.Error("Failed to generate JWT token after registration", err,
+			logs.WithUserID(user.ID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)))
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// issueVerificationToken stores and sends a verification token for user; failures are only logged
+// since the account exists either way
+func (h *AuthHandler) issueVerificationToken(c *gin.Context, user *users.User) {
+	token, hash, err := auth.NewVerificationToken()
+	if err == nil {
+		err = h.verifyTokens.Create(user.ID, hash, time.Now().Add(auth.VerificationTokenTTL))
+	}
+	if err == nil && h.verifySender != nil {
+		err = h.verifySender.SendVerification(user.Email, token)
+	}
+	if err != nil {
+		h.logger.Error("Failed to issue email verification token", err,
+			logs.WithUserID(user.ID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)))
+	}
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Mark the user's email verified using the token issued at registration; tokens are single-use and expire after 24 hours
+// @Tags auth
+// @Produce json
+// @Param token query string true "Verification token"
+// @Success 200 {object} map[string]interface{} "Email verified"
+// @Failure 400 {object} map[string]interface{} "Missing, invalid or expired token"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/verify [get]
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	token := strings.TrimSpace(c.Query("token"))
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	userID, err := h.verifyTokens.Verify(auth.HashVerificationToken(token))
+	if err != nil {
+		if err.Error() == "verification token not found" {
+			h.logger.Warning("Invalid or expired email verification token",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired verification token"})
+			return
+		}
+		h.logger.Error("Failed to verify email", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify email"})
+		return
+	}
+
+	h.logger.Info("Email verified",
+		logs.WithCategory(logs.CategorySecurity),
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{"message": "Email verified"})
+}
+
 // Login godoc
 // @Summary Login user
-// @Description Authenticate user and return JWT token
+// @Description Authenticate a user by email or username and return JWT token
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param credentials body users.LoginRequest true "User login credentials"
-// @Success 200 {object} map[string]interface{} "Login successful with token"
+// @Success 200 {object} map[string]interface{} "Login successful with access and refresh tokens"
 // @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 401 {object} map[string]interface{} "Invalid credentials"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
@@ -48,31 +358,55 @@ func NewAuthHandler(
 func (h *AuthHandler) Login(c *gin.Context) {
 	h.logger.Info("Login attempt started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	var req users.LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Login request validation failed", err,
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
 		return
 	}
 
 	email := strings.ToLower(strings.TrimSpace(req.Email))
+	username := strings.TrimSpace(req.Username)
+	if email == "" && username == "" {
+		h.logger.Warning("Login request without email or username",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Email or username is required"})
+		return
+	}
+
 	h.logger.Info("User login attempt",
 		logs.WithEmail(email),
+		logs.WithUsername(username),
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
-	// Get user by email
-	user, err := h.userRepo.GetByEmail(email)
+	// Unknown users get the same response as wrong passwords so accounts can't be enumerated
+	var user *users.User
+	var err error
+	if email != "" {
+		user, err = h.userRepo.GetByEmail(email)
+	} else {
+		user, err = h.userRepo.GetByUsername(username)
+	}
 	if err != nil {
-		h.logger.Warning("Login attempt with non-existent email",
+		h.logger.Warning("Login attempt with non-existent user",
+			logs.WithCategory(logs.CategorySecurity),
 			logs.WithEmail(email),
+			logs.WithUsername(username),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
 		return
@@ -81,66 +415,316 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	// Check password
 	if !h.passwordService.CheckPasswordHash(req.Password, user.PasswordHash) {
 		h.logger.Warning("Login attempt with invalid password",
-			logs.WithEmail(email),
+			logs.WithCategory(logs.CategorySecurity),
+			logs.WithEmail(user.Email),
 			logs.WithUserID(user.ID),
 			logs.WithUsername(user.Username),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
 		return
 	}
 
 	// Generate JWT token
-	token, err := h.tokenService.GenerateToken(user.ID, user.Username, user.Email)
+	token, err := h.tokenService.GenerateToken(user.ID, user.Username, user.Email, user.Role)
 	if err != nil {
 		h.logger.Error("Failed to generate JWT token", err,
 			logs.WithUserID(user.ID),
 			logs.WithUsername(user.Username),
-			logs.WithEmail(email),
+			logs.WithEmail(user.Email),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return
+	}
+
+	refreshToken, err := h.tokenService.GenerateRefreshToken(user.ID)
+	if err != nil {
+		h.logger.Error("Failed to generate refresh token", err,
+			logs.WithUserID(user.ID),
+			logs.WithEmail(user.Email),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
 		return
 	}
 
+	// A failed stamp only affects the "what's new" summary, so the login still succeeds
+	if err := h.userRepo.RecordLogin(user.ID); err != nil {
+		h.logger.Error("Failed to record login time", err,
+			logs.WithUserID(user.ID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)))
+	}
+
 	h.logger.Info("User login successful",
+		logs.WithCategory(logs.CategorySecurity),
 		logs.WithUserID(user.ID),
 		logs.WithUsername(user.Username),
-		logs.WithEmail(email),
+		logs.WithEmail(user.Email),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK))
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Login successful",
-		"user":    user.ToResponse(),
-		"token":   token,
+		"message":       "Login successful",
+		"user":          user.ToResponse(),
+		"token":         token,
+		"refresh_token": refreshToken,
 	})
 }
 
+// Refresh godoc
+// @Summary Refresh access token
+// @Description Exchange a refresh token for a new access token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body users.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} map[string]interface{} "New access token"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Invalid or expired refresh token"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	h.logger.Info("Token refresh started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	var req users.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Token refresh request validation failed", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+
+	claims, err := h.tokenService.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		h.logger.Warning("Token refresh with invalid refresh token",
+			logs.WithCategory(logs.CategorySecurity),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized),
+			logs.WithMetadata("error", err.Error()))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+	userID := claims.UserID
+
+	if h.blacklist != nil && claims.ID != "" {
+		revoked, err := h.blacklist.IsRevoked(claims.ID)
+		if err != nil {
+			h.logger.Error("Failed to check refresh token revocation", err,
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+			return
+		}
+		if revoked {
+			h.logger.Warning("Token refresh with revoked refresh token",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusUnauthorized))
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+			return
+		}
+	}
+
+	user, err := h.userRepo.GetByID(userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			h.logger.Warning("Token refresh for deleted user",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusUnauthorized))
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+			return
+		}
+		h.logger.Error("Failed to get user for token refresh", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		return
+	}
+
+	token, err := h.tokenService.GenerateToken(user.ID, user.Username, user.Email, user.Role)
+	if err != nil {
+		h.logger.Error("Failed to generate JWT token on refresh", err,
+			logs.WithUserID(user.ID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		return
+	}
+
+	h.logger.Info("Access token refreshed",
+		logs.WithCategory(logs.CategorySecurity),
+		logs.WithUserID(user.ID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{"token": token})
+}
+
+// Logout godoc
+// @Summary Logout user
+// @Description Revoke the access token used for this request so it can no longer be used, and the session's refresh token when it is sent in the body
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body users.LogoutRequest false "Refresh token to revoke"
+// @Success 200 {object} map[string]interface{} "Logged out"
+// @Failure 400 {object} map[string]interface{} "Token cannot be revoked"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Logout attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	tokenID := c.GetString("token_id")
+	expiresAt := c.GetTime("token_expires_at")
+	if h.blacklist == nil || tokenID == "" || expiresAt.IsZero() {
+		h.logger.Warning("Logout with a token that cannot be revoked",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token cannot be revoked; log in again to get a revocable token"})
+		return
+	}
+
+	// The body is optional; without a refresh token only the access token is revoked
+	var req users.LogoutRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			h.logger.Warning("Logout request validation failed",
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest))
+			c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+			return
+		}
+	}
+
+	var refreshClaims *auth.Claims
+	if req.RefreshToken != "" {
+		claims, err := h.tokenService.ValidateRefreshToken(req.RefreshToken)
+		if err != nil || claims.UserID != userID || claims.ID == "" || claims.ExpiresAt == nil {
+			h.logger.Warning("Logout with an invalid refresh token",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid refresh token"})
+			return
+		}
+		refreshClaims = claims
+	}
+
+	if err := h.blacklist.Revoke(tokenID, expiresAt); err != nil {
+		h.logger.Error("Failed to revoke token", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log out"})
+		return
+	}
+
+	if refreshClaims != nil {
+		if err := h.blacklist.Revoke(refreshClaims.ID, refreshClaims.ExpiresAt.Time); err != nil {
+			h.logger.Error("Failed to revoke refresh token", err,
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log out"})
+			return
+		}
+	}
+
+	h.logger.Info("User logged out",
+		logs.WithCategory(logs.CategorySecurity),
+		logs.WithUserID(userID),
+		logs.WithMetadata("refresh_token_revoked", refreshClaims != nil),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out"})
+}
+
 // GetProfile godoc
 // @Summary Get user profile
-// @Description Get the profile of the authenticated user
+// @Description Get the profile of the authenticated user, with the number of features they created and votes they cast
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} users.UserResponse "User profile"
+// @Success 200 {object} map[string]interface{} "User profile and activity stats"
 // @Failure 401 {object} map[string]interface{} "Unauthorized"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /auth/profile [get]
 func (h *AuthHandler) GetProfile(c *gin.Context) {
 	h.logger.Info("Get user profile request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	userID, exists := c.Get("user_id")
 	if !exists {
 		h.logger.Warning("Profile request without authentication",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
@@ -150,7 +734,8 @@ func (h *AuthHandler) GetProfile(c *gin.Context) {
 	h.logger.Debug("Fetching user profile",
 		logs.WithUserID(userIDInt),
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	user, err := h.userRepo.GetByID(userIDInt)
 	if err != nil {
@@ -158,20 +743,55 @@ func (h *AuthHandler) GetProfile(c *gin.Context) {
 			logs.WithUserID(userIDInt),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user profile"})
 		return
 	}
 
+	response := gin.H{
+		"user": user.ToResponse(),
+	}
+
+	if h.featureRepo != nil && h.voteRepo != nil {
+		featuresCreated, err := h.featureRepo.CountByCreator(userIDInt)
+		if err != nil {
+			h.logger.Error("Failed to count features created", err,
+				logs.WithUserID(userIDInt),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user profile"})
+			return
+		}
+
+		votesCast, err := h.voteRepo.CountByUser(userIDInt)
+		if err != nil {
+			h.logger.Error("Failed to count votes cast", err,
+				logs.WithUserID(userIDInt),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user profile"})
+			return
+		}
+
+		response["stats"] = users.ActivityStats{
+			FeaturesCreated: featuresCreated,
+			VotesCast:       votesCast,
+		}
+	}
+
 	h.logger.Info("User profile retrieved successfully",
 		logs.WithUserID(user.ID),
 		logs.WithUsername(user.Username),
 		logs.WithEmail(user.Email),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK))
 
-	c.JSON(http.StatusOK, gin.H{
-		"user": user.ToResponse(),
-	})
-}
\ No newline at end of file
+	c.JSON(http.StatusOK, response)
+}
@@ -7,19 +7,368 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/feature-voting-platform/backend/adapters/auth"
 	authmocks "github.com/feature-voting-platform/backend/adapters/auth/mocks"
+	"github.com/feature-voting-platform/backend/adapters/logs"
 	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
 	"github.com/feature-voting-platform/backend/domain/users"
 	usersmocks "github.com/feature-voting-platform/backend/domain/users/mocks"
+	votesmocks "github.com/feature-voting-platform/backend/domain/votes/mocks"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
-// Note: Register functionality would be tested here if implemented
-// Currently only Login and GetProfile are implemented in auth_handler.go
+func TestAuthHandler_Register(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validBody := map[string]string{
+		"username": "newuser",
+		"email":    "New@Example.com",
+		"password": "password123",
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		blocked        []string
+		policy         *auth.PasswordPolicy
+		setupMocks     func(*usersmocks.MockRepository, *authmocks.MockTokenService, *authmocks.MockPasswordService)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:        "successful registration",
+			requestBody: validBody,
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService) {
+				userRepo.On("EmailExists", "new@example.com").Return(false, nil)
+				userRepo.On("UsernameExists", "newuser").Return(false, nil)
+				passwordService.On("HashPassword", "password123").Return("hashed_password", nil)
+				userRepo.On("Create", mock.MatchedBy(func(u *users.User) bool {
+					return u.Username == "newuser" && u.Email == "new@example.com" && u.PasswordHash == "hashed_password"
+				})).Run(func(args mock.Arguments) {
+					args.Get(0).(*users.User).ID = 7
+				}).Return(nil)
+				tokenService.On("GenerateToken", 7, "newuser", "new@example.com", "").Return("jwt_token", nil)
+				tokenService.On("GenerateRefreshToken", 7).Return("refresh_token", nil)
+			},
+			expectedStatus: http.StatusCreated,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "jwt_token", response["token"])
+				user := response["user"].(map[string]interface{})
+				assert.Equal(t, float64(7), user["id"])
+				assert.Equal(t, "new@example.com", user["email"])
+			},
+		},
+		{
+			name: "invalid email",
+			requestBody: map[string]string{
+				"username": "newuser",
+				"email":    "not-an-email",
+				"password": "password123",
+			},
+			setupMocks:     func(*usersmocks.MockRepository, *authmocks.MockTokenService, *authmocks.MockPasswordService) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, []interface{}{
+					map[string]interface{}{"field": "email", "message": "must be a valid email address"},
+				}, response["errors"])
+			},
+		},
+		{
+			name: "password shorter than the default policy",
+			requestBody: map[string]string{
+				"username": "newuser",
+				"email":    "new@example.com",
+				"password": "12345",
+			},
+			setupMocks:     func(*usersmocks.MockRepository, *authmocks.MockTokenService, *authmocks.MockPasswordService) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, []interface{}{
+					map[string]interface{}{"field": "password", "message": "must be at least 6 characters"},
+				}, response["errors"])
+			},
+		},
+		{
+			name:           "password fails the configured policy",
+			requestBody:    validBody,
+			policy:         &auth.PasswordPolicy{MinLength: 8, RequireUpper: true, RequireSpecial: true},
+			setupMocks:     func(*usersmocks.MockRepository, *authmocks.MockTokenService, *authmocks.MockPasswordService) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, []interface{}{
+					map[string]interface{}{"field": "password", "message": "must contain an uppercase letter"},
+					map[string]interface{}{"field": "password", "message": "must contain a special character"},
+				}, response["errors"])
+			},
+		},
+		{
+			name:           "disposable email",
+			requestBody:    validBody,
+			blocked:        []string{"example.com"},
+			setupMocks:     func(*usersmocks.MockRepository, *authmocks.MockTokenService, *authmocks.MockPasswordService) {},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "email taken",
+			requestBody: validBody,
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService) {
+				userRepo.On("EmailExists", "new@example.com").Return(true, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "username taken",
+			requestBody: validBody,
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService) {
+				userRepo.On("EmailExists", "new@example.com").Return(false, nil)
+				userRepo.On("UsernameExists", "newuser").Return(true, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "concurrent duplicate on create",
+			requestBody: validBody,
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService) {
+				userRepo.On("EmailExists", "new@example.com").Return(false, nil)
+				userRepo.On("UsernameExists", "newuser").Return(false, nil)
+				passwordService.On("HashPassword", "password123").Return("hashed_password", nil)
+				userRepo.On("Create", mock.Anything).Return(fmt.Errorf("user already exists"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "database error",
+			requestBody: validBody,
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService) {
+				userRepo.On("EmailExists", "new@example.com").Return(false, fmt.Errorf("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := usersmocks.NewMockRepository(t)
+			tokenService := authmocks.NewMockTokenService(t)
+			passwordService := authmocks.NewMockPasswordService(t)
+			logger := logsmocks.NewMockLogger(t)
+
+			tt.setupMocks(userRepo, tokenService, passwordService)
+			expectAnyLogs(logger)
+
+			handler := NewAuthHandler(userRepo, tokenService, passwordService, logger).
+				WithDisposableEmailChecker(auth.NewDisposableEmailChecker(tt.blocked))
+			if tt.policy != nil {
+				handler.WithPasswordPolicy(*tt.policy)
+			}
+
+			body, _ := json.Marshal(tt.requestBody)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/auth/register", bytes.NewBuffer(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.Register(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.checkResponse != nil {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				tt.checkResponse(t, response)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// A real service so the test exercises the token-type checks, not a mock of them
+	tokenService := auth.NewJWTService("test-secret")
+	accessToken, err := tokenService.GenerateToken(1, "testuser", "test@example.com", "user")
+	require.NoError(t, err)
+	refreshToken, err := tokenService.GenerateRefreshToken(1)
+	require.NoError(t, err)
+	expiredRefresh, err := auth.NewJWTService("test-secret").WithRefreshTTL(time.Nanosecond).GenerateRefreshToken(1)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+
+	tests := []struct {
+		name           string
+		refreshToken   string
+		setupMocks     func(*usersmocks.MockRepository)
+		expectedStatus int
+	}{
+		{
+			name:         "valid refresh token",
+			refreshToken: refreshToken,
+			setupMocks: func(userRepo *usersmocks.MockRepository) {
+				userRepo.On("GetByID", 1).Return(&users.User{ID: 1, Username: "testuser", Email: "test@example.com"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "access token used as refresh token",
+			refreshToken:   accessToken,
+			setupMocks:     func(*usersmocks.MockRepository) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "expired refresh token",
+			refreshToken:   expiredRefresh,
+			setupMocks:     func(*usersmocks.MockRepository) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "user deleted since issue",
+			refreshToken: refreshToken,
+			setupMocks: func(userRepo *usersmocks.MockRepository) {
+				userRepo.On("GetByID", 1).Return(nil, fmt.Errorf("user not found"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing refresh token",
+			refreshToken:   "",
+			setupMocks:     func(*usersmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := usersmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			tt.setupMocks(userRepo)
+			expectAnyLogs(logger)
+
+			handler := NewAuthHandler(userRepo, tokenService, authmocks.NewMockPasswordService(t), logger)
+
+			body, _ := json.Marshal(map[string]string{"refresh_token": tt.refreshToken})
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBuffer(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.Refresh(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				claims, err := tokenService.ValidateToken(response["token"].(string))
+				require.NoError(t, err)
+				assert.Equal(t, "testuser", claims.Username)
+			}
+			if tt.expectedStatus == http.StatusBadRequest {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, []interface{}{
+					map[string]interface{}{"field": "refresh_token", "message": "is required"},
+				}, response["errors"])
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenService := auth.NewJWTService("test-secret")
+	blacklist := auth.NewMemoryTokenBlacklist()
+	logger := logsmocks.NewMockLogger(t)
+	expectAnyLogs(logger)
+
+	handler := NewAuthHandler(usersmocks.NewMockRepository(t), tokenService, authmocks.NewMockPasswordService(t), logger).
+		WithTokenBlacklist(blacklist)
+	requireAuth := AuthMiddlewareWithBlacklist(tokenService, blacklist, logger)
+
+	router := gin.New()
+	router.POST("/auth/logout", requireAuth, handler.Logout)
+	router.GET("/protected", requireAuth, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	token, err := tokenService.GenerateToken(1, "testuser", "test@example.com", "user")
+	require.NoError(t, err)
+	otherToken, err := tokenService.GenerateToken(1, "testuser", "test@example.com", "user")
+	require.NoError(t, err)
+
+	send := func(method, path, bearer string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+bearer)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, send(http.MethodGet, "/protected", token))
+	assert.Equal(t, http.StatusOK, send(http.MethodPost, "/auth/logout", token))
+	assert.Equal(t, http.StatusUnauthorized, send(http.MethodGet, "/protected", token))
+	assert.Equal(t, http.StatusUnauthorized, send(http.MethodPost, "/auth/logout", token))
+
+	// Only the logged-out token is revoked, not the user's other sessions
+	assert.Equal(t, http.StatusOK, send(http.MethodGet, "/protected", otherToken))
+}
+
+func TestAuthHandler_Logout_RevokesRefreshToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenService := auth.NewJWTService("test-secret")
+	blacklist := auth.NewMemoryTokenBlacklist()
+	logger := logsmocks.NewMockLogger(t)
+	expectAnyLogs(logger)
+	userRepo := usersmocks.NewMockRepository(t)
+	userRepo.On("GetByID", 1).Return(&users.User{ID: 1, Username: "testuser", Email: "test@example.com"}, nil).Maybe()
+
+	handler := NewAuthHandler(userRepo, tokenService, authmocks.NewMockPasswordService(t), logger).
+		WithTokenBlacklist(blacklist)
+	requireAuth := AuthMiddlewareWithBlacklist(tokenService, blacklist, logger)
+
+	router := gin.New()
+	router.POST("/auth/logout", requireAuth, handler.Logout)
+	router.POST("/auth/refresh", handler.Refresh)
+
+	send := func(path, bearer string, body map[string]string) int {
+		payload, _ := json.Marshal(body)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(payload))
+		req.Header.Set("Content-Type", "application/json")
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	accessToken, err := tokenService.GenerateToken(1, "testuser", "test@example.com", "user")
+	require.NoError(t, err)
+	refreshToken, err := tokenService.GenerateRefreshToken(1)
+	require.NoError(t, err)
+	otherRefresh, err := tokenService.GenerateRefreshToken(1)
+	require.NoError(t, err)
+	strangerRefresh, err := tokenService.GenerateRefreshToken(2)
+	require.NoError(t, err)
+
+	t.Run("refresh token of another user is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, send("/auth/logout", accessToken, map[string]string{"refresh_token": strangerRefresh}))
+		assert.Equal(t, http.StatusOK, send("/auth/refresh", "", map[string]string{"refresh_token": refreshToken}))
+	})
+
+	t.Run("logout revokes the refresh token", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, send("/auth/logout", accessToken, map[string]string{"refresh_token": refreshToken}))
+		assert.Equal(t, http.StatusUnauthorized, send("/auth/refresh", "", map[string]string{"refresh_token": refreshToken}))
+
+		// Other sessions keep working
+		assert.Equal(t, http.StatusOK, send("/auth/refresh", "", map[string]string{"refresh_token": otherRefresh}))
+	})
+}
 
 func TestAuthHandler_Login(t *testing.T) {
 	gin.SetMode(gin.TestMode)
@@ -46,12 +395,15 @@ func TestAuthHandler_Login(t *testing.T) {
 				}
 				userRepo.On("GetByEmail", "test@example.com").Return(user, nil)
 				passwordService.On("CheckPasswordHash", "password123", "hashed_password").Return(true)
-				tokenService.On("GenerateToken", 1, "testuser", "test@example.com").Return("jwt_token", nil)
-				logger.On("Info", mock.AnythingOfType("string"), mock.Anything)
+				tokenService.On("GenerateToken", 1, "testuser", "test@example.com", "").Return("jwt_token", nil)
+				tokenService.On("GenerateRefreshToken", 1).Return("refresh_token", nil)
+				userRepo.On("RecordLogin", 1).Return(nil)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
 				assert.Equal(t, "jwt_token", response["token"])
+				assert.Equal(t, "refresh_token", response["refresh_token"])
 				user := response["user"].(map[string]interface{})
 				assert.Equal(t, float64(1), user["id"])
 				assert.Equal(t, "testuser", user["username"])
@@ -61,7 +413,7 @@ func TestAuthHandler_Login(t *testing.T) {
 		{
 			name: "successful login with username",
 			requestBody: map[string]string{
-				"username": "testuser",
+				"username": " testuser ",
 				"password": "password123",
 			},
 			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
@@ -73,14 +425,58 @@ func TestAuthHandler_Login(t *testing.T) {
 				}
 				userRepo.On("GetByUsername", "testuser").Return(user, nil)
 				passwordService.On("CheckPasswordHash", "password123", "hashed_password").Return(true)
-				tokenService.On("GenerateToken", 1, "testuser", "test@example.com").Return("jwt_token", nil)
-				logger.On("Info", mock.AnythingOfType("string"), mock.Anything)
+				tokenService.On("GenerateToken", 1, "testuser", "test@example.com", "").Return("jwt_token", nil)
+				tokenService.On("GenerateRefreshToken", 1).Return("refresh_token", nil)
+				userRepo.On("RecordLogin", 1).Return(nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "jwt_token", response["token"])
+				user := response["user"].(map[string]interface{})
+				assert.Equal(t, "testuser", user["username"])
+			},
+		},
+		{
+			name: "email takes precedence over username",
+			requestBody: map[string]string{
+				"email":    "test@example.com",
+				"username": "otheruser",
+				"password": "password123",
+			},
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
+				user := &users.User{
+					ID:           1,
+					Username:     "testuser",
+					Email:        "test@example.com",
+					PasswordHash: "hashed_password",
+				}
+				userRepo.On("GetByEmail", "test@example.com").Return(user, nil)
+				passwordService.On("CheckPasswordHash", "password123", "hashed_password").Return(true)
+				tokenService.On("GenerateToken", 1, "testuser", "test@example.com", "").Return("jwt_token", nil)
+				tokenService.On("GenerateRefreshToken", 1).Return("refresh_token", nil)
+				userRepo.On("RecordLogin", 1).Return(nil)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
 				assert.Equal(t, "jwt_token", response["token"])
 			},
 		},
+		{
+			name: "neither email nor username",
+			requestBody: map[string]string{
+				"username": "  ",
+				"password": "password123",
+			},
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Email or username is required", response["error"])
+			},
+		},
 		{
 			name: "invalid credentials - wrong password",
 			requestBody: map[string]string{
@@ -96,13 +492,41 @@ func TestAuthHandler_Login(t *testing.T) {
 				}
 				userRepo.On("GetByEmail", "test@example.com").Return(user, nil)
 				passwordService.On("CheckPasswordHash", "wrongpassword", "hashed_password").Return(false)
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusUnauthorized,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
 				assert.Equal(t, "Invalid credentials", response["error"])
 			},
 		},
+		{
+			name: "invalid email and missing password",
+			requestBody: map[string]string{
+				"email": "not-an-email",
+			},
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, []interface{}{
+					map[string]interface{}{"field": "email", "message": "must be a valid email address"},
+					map[string]interface{}{"field": "password", "message": "is required"},
+				}, response["errors"])
+			},
+		},
+		{
+			name:        "malformed JSON",
+			requestBody: `{"email":`,
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "invalid request body", response["error"])
+				assert.NotContains(t, response, "errors")
+			},
+		},
 		{
 			name: "user not found",
 			requestBody: map[string]string{
@@ -111,7 +535,43 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
 				userRepo.On("GetByEmail", "nonexistent@example.com").Return(nil, fmt.Errorf("user not found"))
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Invalid credentials", response["error"])
+			},
+		},
+		{
+			name: "unknown username",
+			requestBody: map[string]string{
+				"username": "ghost",
+				"password": "password123",
+			},
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
+				userRepo.On("GetByUsername", "ghost").Return(nil, fmt.Errorf("user not found"))
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Invalid credentials", response["error"])
+			},
+		},
+		{
+			name: "username with wrong password",
+			requestBody: map[string]string{
+				"username": "testuser",
+				"password": "wrongpassword",
+			},
+			setupMocks: func(userRepo *usersmocks.MockRepository, tokenService *authmocks.MockTokenService, passwordService *authmocks.MockPasswordService, logger *logsmocks.MockLogger) {
+				userRepo.On("GetByUsername", "testuser").Return(&users.User{
+					ID:           1,
+					Username:     "testuser",
+					Email:        "test@example.com",
+					PasswordHash: "hashed_password",
+				}, nil)
+				passwordService.On("CheckPasswordHash", "wrongpassword", "hashed_password").Return(false)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusUnauthorized,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
@@ -142,10 +602,10 @@ func TestAuthHandler_Login(t *testing.T) {
 			c, router := gin.CreateTestContext(w)
 
 			router.POST("/login", handler.Login)
-			
+
 			req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(requestBody))
 			req.Header.Set("Content-Type", "application/json")
-			
+
 			c.Request = req
 			router.ServeHTTP(w, req)
 
@@ -158,4 +618,250 @@ func TestAuthHandler_Login(t *testing.T) {
 			tt.checkResponse(t, response)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestAuthHandler_Login_FailureLoggedAsSecurityEvent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userRepo := usersmocks.NewMockRepository(t)
+	tokenService := authmocks.NewMockTokenService(t)
+	passwordService := authmocks.NewMockPasswordService(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewAuthHandler(userRepo, tokenService, passwordService, logger)
+
+	userRepo.On("GetByEmail", "test@example.com").Return(&users.User{
+		ID:           1,
+		Username:     "testuser",
+		Email:        "test@example.com",
+		PasswordHash: "hashed_password",
+	}, nil)
+	passwordService.On("CheckPasswordHash", "wrongpassword", "hashed_password").Return(false)
+	entries := captureLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.POST("/login", handler.Login)
+
+	body, _ := json.Marshal(map[string]string{"email": "test@example.com", "password": "wrongpassword"})
+	req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var securityEntries []logs.LogEntry
+	for _, entry := range *entries {
+		if entry.Category == logs.CategorySecurity {
+			securityEntries = append(securityEntries, entry)
+		}
+	}
+	require.Len(t, securityEntries, 1)
+	assert.Equal(t, logs.LogLevelWarning, securityEntries[0].Level)
+	assert.Equal(t, "test@example.com", securityEntries[0].Email)
+}
+
+func TestAuthHandler_GetProfile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*featuresmocks.MockRepository, *votesmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "stats include features created and votes cast",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("CountByCreator", 1).Return(3, nil)
+				voteRepo.On("CountByUser", 1).Return(12, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				stats := response["stats"].(map[string]interface{})
+				assert.Equal(t, float64(3), stats["features_created"])
+				assert.Equal(t, float64(12), stats["votes_cast"])
+			},
+		},
+		{
+			name: "user without activity gets zeros",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("CountByCreator", 1).Return(0, nil)
+				voteRepo.On("CountByUser", 1).Return(0, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				stats := response["stats"].(map[string]interface{})
+				assert.Equal(t, float64(0), stats["features_created"])
+				assert.Equal(t, float64(0), stats["votes_cast"])
+			},
+		},
+		{
+			name: "count failure",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("CountByCreator", 1).Return(0, fmt.Errorf("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Failed to get user profile", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := usersmocks.NewMockRepository(t)
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewAuthHandler(userRepo, authmocks.NewMockTokenService(t), authmocks.NewMockPasswordService(t), logger).
+				WithProfileStats(featureRepo, voteRepo)
+
+			userRepo.On("GetByID", 1).Return(&users.User{ID: 1, Username: "testuser", Email: "test@example.com"}, nil)
+			tt.setupMocks(featureRepo, voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/auth/profile", handler.GetProfile)
+
+			req, _ := http.NewRequest(http.MethodGet, "/auth/profile", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+type recordingVerificationSender struct {
+	email, token string
+}
+
+func (s *recordingVerificationSender) SendVerification(email, token string) error {
+	s.email, s.token = email, token
+	return nil
+}
+
+func TestAuthHandler_Register_IssuesVerificationToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userRepo := usersmocks.NewMockRepository(t)
+	tokenRepo := usersmocks.NewMockVerificationTokenRepository(t)
+	passwordService := authmocks.NewMockPasswordService(t)
+	logger := logsmocks.NewMockLogger(t)
+	sender := &recordingVerificationSender{}
+	expectAnyLogs(logger)
+
+	userRepo.On("EmailExists", "new@example.com").Return(false, nil)
+	userRepo.On("UsernameExists", "newuser").Return(false, nil)
+	passwordService.On("HashPassword", "password123").Return("hashed_password", nil)
+	userRepo.On("Create", mock.AnythingOfType("*users.User")).Run(func(args mock.Arguments) {
+		args.Get(0).(*users.User).ID = 7
+	}).Return(nil)
+
+	var storedHash string
+	var storedExpiry time.Time
+	tokenRepo.On("Create", 7, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Run(func(args mock.Arguments) {
+		storedHash = args.String(1)
+		storedExpiry = args.Get(2).(time.Time)
+	}).Return(nil)
+
+	handler := NewAuthHandler(userRepo, auth.NewJWTService("test-secret"), passwordService, logger).
+		WithEmailVerification(tokenRepo, sender)
+
+	body, _ := json.Marshal(map[string]string{"username": "newuser", "email": "new@example.com", "password": "password123"})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/auth/register", bytes.NewBuffer(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	handler.Register(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "new@example.com", sender.email)
+	assert.NotEmpty(t, sender.token)
+	// Only the hash of the emailed token is stored
+	assert.Equal(t, auth.HashVerificationToken(sender.token), storedHash)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), storedExpiry, time.Minute)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, false, response["user"].(map[string]interface{})["email_verified"])
+}
+
+func TestAuthHandler_VerifyEmail(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*usersmocks.MockVerificationTokenRepository)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:  "valid token",
+			query: "?token=abc",
+			setupMocks: func(repo *usersmocks.MockVerificationTokenRepository) {
+				repo.On("Verify", auth.HashVerificationToken("abc")).Return(7, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   map[string]interface{}{"message": "Email verified"},
+		},
+		{
+			name:           "missing token",
+			query:          "",
+			setupMocks:     func(repo *usersmocks.MockVerificationTokenRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   map[string]interface{}{"error": "token is required"},
+		},
+		{
+			name:  "used or expired token",
+			query: "?token=abc",
+			setupMocks: func(repo *usersmocks.MockVerificationTokenRepository) {
+				repo.On("Verify", auth.HashVerificationToken("abc")).Return(0, fmt.Errorf("verification token not found"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   map[string]interface{}{"error": "Invalid or expired verification token"},
+		},
+		{
+			name:  "repository error",
+			query: "?token=abc",
+			setupMocks: func(repo *usersmocks.MockVerificationTokenRepository) {
+				repo.On("Verify", auth.HashVerificationToken("abc")).Return(0, fmt.Errorf("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   map[string]interface{}{"error": "Failed to verify email"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenRepo := usersmocks.NewMockVerificationTokenRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+
+			tt.setupMocks(tokenRepo)
+			expectAnyLogs(logger)
+
+			handler := NewAuthHandler(usersmocks.NewMockRepository(t), authmocks.NewMockTokenService(t), authmocks.NewMockPasswordService(t), logger).
+				WithEmailVerification(tokenRepo, nil)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/auth/verify"+tt.query, nil)
+
+			handler.VerifyEmail(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedBody, response)
+		})
+	}
+}
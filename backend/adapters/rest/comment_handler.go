@@ -0,0 +1,233 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/comments"
+	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/gin-gonic/gin"
+)
+
+// CommentHandler handles feature discussion HTTP requests
+type CommentHandler struct {
+	featureRepo features.Repository
+	commentRepo comments.Repository
+	logger      logs.Logger
+}
+
+// NewCommentHandler creates a new comment handler
+func NewCommentHandler(featureRepo features.Repository, commentRepo comments.Repository, logger logs.Logger) *CommentHandler {
+	return &CommentHandler{
+		featureRepo: featureRepo,
+		commentRepo: commentRepo,
+		logger:      logger,
+	}
+}
+
+// CreateComment godoc
+// @Summary Comment on a feature
+// @Description Add a comment to a feature's discussion thread
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param request body comments.CreateCommentRequest true "Comment body"
+// @Success 201 {object} map[string]interface{} "Comment added successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 422 {object} map[string]interface{} "Comment too long"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/comments [post]
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	h.logger.Info("Create comment request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for comment",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Comment attempt without authentication",
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req comments.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Comment request validation failed", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Comment body is required"})
+		return
+	}
+	if utf8.RuneCountInString(body) > comments.MaxBodyLength {
+		h.logger.Warning("Comment exceeds length limit",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": fmt.Sprintf("Comment must be at most %d characters", comments.MaxBodyLength)})
+		return
+	}
+
+	exists, err = h.featureRepo.FeatureExists(featureID)
+	if err != nil {
+		h.logger.Error("Failed to check feature existence for comment", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+		return
+	}
+	if !exists {
+		h.logger.Info("Comment attempt on non-existent feature",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusNotFound))
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	comment := &comments.Comment{
+		FeatureID: featureID,
+		UserID:    userID,
+		Body:      body,
+	}
+	if err := h.commentRepo.Create(comment); err != nil {
+		h.logger.Error("Failed to create comment in database", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create comment"})
+		return
+	}
+
+	h.logger.Info("Comment created successfully",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusCreated),
+		logs.WithMetadata("comment_id", comment.ID))
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Comment added successfully",
+		"comment": comment,
+	})
+}
+
+// GetComments godoc
+// @Summary Get a feature's comments
+// @Description Get the discussion thread of a feature, oldest comment first
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Feature ID"
+// @Success 200 {object} map[string]interface{} "Feature comments"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/comments [get]
+func (h *CommentHandler) GetComments(c *gin.Context) {
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for comments",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	exists, err := h.featureRepo.FeatureExists(featureID)
+	if err != nil {
+		h.logger.Error("Failed to check feature existence for comments", err,
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	commentList, err := h.commentRepo.GetByFeatureID(featureID)
+	if err != nil {
+		h.logger.Error("Failed to get comments from database", err,
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get comments"})
+		return
+	}
+	if commentList == nil {
+		commentList = []comments.Comment{}
+	}
+
+	h.logger.Debug("Comments retrieved",
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("comment_count", len(commentList)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"feature_id": featureID,
+		"comments":   commentList,
+	})
+}
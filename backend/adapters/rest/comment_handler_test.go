@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/comments"
+	commentsmocks "github.com/feature-voting-platform/backend/domain/comments/mocks"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentHandler_CreateComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		featureID      string
+		requestBody    string
+		setupMocks     func(*featuresmocks.MockRepository, *commentsmocks.MockRepository)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:        "comment added",
+			featureID:   "1",
+			requestBody: `{"body": "  Would this cover mobile too?  "}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, commentRepo *commentsmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				commentRepo.On("Create", mock.MatchedBy(func(comment *comments.Comment) bool {
+					return comment.FeatureID == 1 && comment.UserID == 1 && comment.Body == "Would this cover mobile too?"
+				})).Run(func(args mock.Arguments) {
+					args.Get(0).(*comments.Comment).ID = 10
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"message": "Comment added successfully",
+			},
+		},
+		{
+			name:        "blank body",
+			featureID:   "1",
+			requestBody: `{"body": "   "}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, commentRepo *commentsmocks.MockRepository) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "Comment body is required",
+			},
+		},
+		{
+			name:        "body too long",
+			featureID:   "1",
+			requestBody: `{"body": "` + strings.Repeat("c", comments.MaxBodyLength+1) + `"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, commentRepo *commentsmocks.MockRepository) {
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "Comment must be at most 2000 characters",
+			},
+		},
+		{
+			name:        "feature not found",
+			featureID:   "99",
+			requestBody: `{"body": "Hello"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, commentRepo *commentsmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 99).Return(false, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "Feature not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			commentRepo := commentsmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewCommentHandler(featureRepo, commentRepo, logger)
+
+			tt.setupMocks(featureRepo, commentRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/features/:id/comments", handler.CreateComment)
+
+			req, _ := http.NewRequest(http.MethodPost, "/features/"+tt.featureID+"/comments", strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestCommentHandler_GetComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	featureRepo := featuresmocks.NewMockRepository(t)
+	commentRepo := commentsmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewCommentHandler(featureRepo, commentRepo, logger)
+
+	now := time.Now()
+	featureRepo.On("FeatureExists", 1).Return(true, nil)
+	commentRepo.On("GetByFeatureID", 1).Return([]comments.Comment{
+		{ID: 1, FeatureID: 1, UserID: 2, Body: "First", CreatedAt: now.Add(-time.Hour)},
+		{ID: 2, FeatureID: 1, UserID: 3, Body: "Second", CreatedAt: now},
+	}, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.GET("/features/:id/comments", handler.GetComments)
+
+	req, _ := http.NewRequest(http.MethodGet, "/features/1/comments", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		FeatureID int                `json:"feature_id"`
+		Comments  []comments.Comment `json:"comments"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.FeatureID)
+	require.Len(t, response.Comments, 2)
+	assert.Equal(t, "First", response.Comments[0].Body)
+}
@@ -1,28 +1,215 @@
 package rest
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/activity"
 	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/feature-voting-platform/backend/domain/users"
 	"github.com/gin-gonic/gin"
 )
 
 // FeatureHandler handles feature-related HTTP requests
 type FeatureHandler struct {
-	featureRepo features.Repository
-	logger      logs.Logger
+	featureRepo          features.Repository
+	logger               logs.Logger
+	maxTitleLength       int
+	maxDescriptionLength int
+	minEditInterval      time.Duration
+	requireDistinctText  bool
+	hideVoteCounts       bool
+	newWindow            time.Duration
+	activity             activity.Repository
+	minDescriptionWords  int
+	minDescriptionChange int
+	linkHeaders          bool
+	surgeMultiplier      float64
+	categoryCounts       bool
+	metrics              *Metrics
+	verifiedUsers        users.Repository
 }
 
 // NewFeatureHandler creates a new feature handler
 func NewFeatureHandler(featureRepo features.Repository, logger logs.Logger) *FeatureHandler {
 	return &FeatureHandler{
-		featureRepo: featureRepo,
-		logger:      logger,
+		featureRepo:          featureRepo,
+		logger:               logger,
+		maxTitleLength:       features.MaxTitleLength,
+		maxDescriptionLength: features.MaxDescriptionLength,
+		surgeMultiplier:      features.DefaultSurgeMultiplier,
 	}
 }
 
+// WithMaxLengths lowers the accepted title and description lengths. Values that are
+// zero or above the database column limits fall back to those limits.
+func (h *FeatureHandler) WithMaxLengths(maxTitle, maxDescription int) *FeatureHandler {
+	if maxTitle > 0 && maxTitle < features.MaxTitleLength {
+		h.maxTitleLength = maxTitle
+	}
+	if maxDescription > 0 && maxDescription < features.MaxDescriptionLength {
+		h.maxDescriptionLength = maxDescription
+	}
+	return h
+}
+
+// WithMinEditInterval rejects edits to a feature made sooner than interval after its last update;
+// zero disables the check
+func (h *FeatureHandler) WithMinEditInterval(interval time.Duration) *FeatureHandler {
+	h.minEditInterval = interval
+	return h
+}
+
+// WithDistinctDescription rejects features whose description merely repeats the title
+func (h *FeatureHandler) WithDistinctDescription(enabled bool) *FeatureHandler {
+	h.requireDistinctText = enabled
+	return h
+}
+
+// WithMinDescriptionChange rejects description edits that alter fewer than minChars
+// characters; 0 disables the check
+func (h *FeatureHandler) WithMinDescriptionChange(minChars int) *FeatureHandler {
+	h.minDescriptionChange = minChars
+	return h
+}
+
+// WithDescriptionQuality requires descriptions to have at least minWords words and a
+// complete sentence; disabled when enabled is false or minWords is not positive
+func (h *FeatureHandler) WithDescriptionQuality(enabled bool, minWords int) *FeatureHandler {
+	if enabled && minWords > 0 {
+		h.minDescriptionWords = minWords
+	}
+	return h
+}
+
+// WithLinkHeaders adds RFC 5988 Link headers to paginated feature listings
+func (h *FeatureHandler) WithLinkHeaders(enabled bool) *FeatureHandler {
+	h.linkHeaders = enabled
+	return h
+}
+
+// WithHiddenVoteCounts hides a feature's vote count from viewers until they have voted on it
+func (h *FeatureHandler) WithHiddenVoteCounts(enabled bool) *FeatureHandler {
+	h.hideVoteCounts = enabled
+	return h
+}
+
+// WithNewWindow flags features created within window as new in responses; 0 disables the flag
+func (h *FeatureHandler) WithNewWindow(window time.Duration) *FeatureHandler {
+	h.newWindow = window
+	return h
+}
+
+// WithSurgeMultiplier sets how far a feature's last-24-hour votes must exceed its prior daily
+// average to be reported as surging. Values not above 1 keep the default.
+func (h *FeatureHandler) WithSurgeMultiplier(multiplier float64) *FeatureHandler {
+	if multiplier > 1 {
+		h.surgeMultiplier = multiplier
+	}
+	return h
+}
+
+// WithCategoryCounts adds per-category vote counts to single-feature responses; enable it
+// when votes may be cast in named categories
+func (h *FeatureHandler) WithCategoryCounts(enabled bool) *FeatureHandler {
+	h.categoryCounts = enabled
+	return h
+}
+
+// WithActivity records feature creations in the activity stream
+func (h *FeatureHandler) WithActivity(repo activity.Repository) *FeatureHandler {
+	h.activity = repo
+	return h
+}
+
+// WithMetrics counts created features in the Prometheus metrics
+func (h *FeatureHandler) WithMetrics(m *Metrics) *FeatureHandler {
+	h.metrics = m
+	return h
+}
+
+// WithEmailVerificationRequired only lets users whose email is verified create features
+func (h *FeatureHandler) WithEmailVerificationRequired(userRepo users.Repository) *FeatureHandler {
+	h.verifiedUsers = userRepo
+	return h
+}
+
+// applyFreshness sets is_new on features created within the configured recency window
+func (h *FeatureHandler) applyFreshness(list []features.Feature) {
+	now := time.Now()
+	for i := range list {
+		list[i].MarkFreshness(h.newWindow, now)
+	}
+}
+
+// applyVoteCountVisibility hides vote counts the viewer may not see yet, when enabled
+func (h *FeatureHandler) applyVoteCountVisibility(list []features.Feature, viewerID *int, viewerRole string) {
+	if !h.hideVoteCounts {
+		return
+	}
+	for i := range list {
+		list[i].HideVoteCountFrom(viewerID, viewerRole)
+	}
+}
+
+// expiryViolation returns a client-facing message when a requested voting deadline is not in the future
+func expiryViolation(expiresAt *time.Time) string {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return "Expiry must be in the future"
+	}
+	return ""
+}
+
+// contentViolation returns a client-facing message when the description just repeats the title
+func (h *FeatureHandler) contentViolation(title, description string) string {
+	if h.requireDistinctText && features.DescriptionRepeatsTitle(title, description) {
+		return "Description must differ from the title"
+	}
+	return ""
+}
+
+// changeViolation returns a client-facing message when an edited description differs from the
+// stored one by fewer than the configured number of characters; unchanged descriptions pass
+func (h *FeatureHandler) changeViolation(previous string, description *string) string {
+	if h.minDescriptionChange <= 0 || description == nil || *description == previous {
+		return ""
+	}
+	if features.EditDistance(previous, *description) < h.minDescriptionChange {
+		return fmt.Sprintf("Description edits must change at least %d characters", h.minDescriptionChange)
+	}
+	return ""
+}
+
+// qualityViolation returns client-facing guidance when a new description fails the quality heuristic
+func (h *FeatureHandler) qualityViolation(description *string) string {
+	if h.minDescriptionWords == 0 || description == nil {
+		return ""
+	}
+	return features.DescriptionQualityIssue(*description, h.minDescriptionWords)
+}
+
+// lengthViolation returns a client-facing message when the title or description is too long
+func (h *FeatureHandler) lengthViolation(title, description *string) string {
+	if title != nil && utf8.RuneCountInString(*title) > h.maxTitleLength {
+		return fmt.Sprintf("Title must be at most %d characters", h.maxTitleLength)
+	}
+	if description != nil && utf8.RuneCountInString(*description) > h.maxDescriptionLength {
+		return fmt.Sprintf("Description must be at most %d characters", h.maxDescriptionLength)
+	}
+	return ""
+}
+
+// isLengthError reports whether a repository error is a length-limit rejection
+func isLengthError(err error) bool {
+	return errors.Is(err, features.ErrTitleTooLong) || errors.Is(err, features.ErrDescriptionTooLong)
+}
+
 // CreateFeature godoc
 // @Summary Create a new feature
 // @Description Create a new feature request
@@ -34,20 +221,24 @@ func NewFeatureHandler(featureRepo features.Repository, logger logs.Logger) *Fea
 // @Success 201 {object} features.Feature "Feature created successfully"
 // @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Email not verified"
+// @Failure 422 {object} map[string]interface{} "Validation failed"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /features [post]
 func (h *FeatureHandler) CreateFeature(c *gin.Context) {
 	h.logger.Info("Create feature request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	var req features.CreateFeatureRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Create feature request validation failed", err,
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
 		return
 	}
 
@@ -56,15 +247,85 @@ func (h *FeatureHandler) CreateFeature(c *gin.Context) {
 		h.logger.Warning("Create feature attempt without authentication",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
+	if h.verifiedUsers != nil {
+		user, err := h.verifiedUsers.GetByID(userID)
+		if err != nil {
+			h.logger.Error("Failed to check email verification", err,
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create feature"})
+			return
+		}
+		if !user.EmailVerified {
+			h.logger.Warning("Create feature attempt with unverified email",
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusForbidden))
+			c.JSON(http.StatusForbidden, gin.H{"error": "Verify your email address before creating features"})
+			return
+		}
+	}
+
+	if msg := h.lengthViolation(&req.Title, &req.Description); msg != "" {
+		h.logger.Warning("Create feature request exceeds length limits",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if msg := h.qualityViolation(&req.Description); msg != "" {
+		h.logger.Warning("Create feature request description fails quality check",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if msg := h.contentViolation(req.Title, req.Description); msg != "" {
+		h.logger.Warning("Create feature request description repeats title",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if msg := expiryViolation(req.ExpiresAt); msg != "" {
+		h.logger.Warning("Create feature request has an expiry in the past",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
 	h.logger.Info("Creating new feature",
 		logs.WithUserID(userID),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithMetadata("feature_title", req.Title),
 		logs.WithMetadata("description_length", len(req.Description)))
 
@@ -72,18 +333,25 @@ func (h *FeatureHandler) CreateFeature(c *gin.Context) {
 		Title:       req.Title,
 		Description: req.Description,
 		CreatedBy:   userID,
+		ExpiresAt:   req.ExpiresAt,
 	}
 
 	if err := h.featureRepo.Create(feature); err != nil {
+		if isLengthError(err) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to create feature in database", err,
 			logs.WithUserID(userID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError),
 			logs.WithMetadata("feature_title", req.Title))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create feature"})
 		return
 	}
+	h.metrics.FeatureCreated()
 
 	// Get the created feature with user info
 	createdFeature, err := h.featureRepo.GetByID(feature.ID, &userID)
@@ -93,20 +361,29 @@ func (h *FeatureHandler) CreateFeature(c *gin.Context) {
 			logs.WithFeatureID(feature.ID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get created feature"})
 		return
 	}
 
+	recordActivity(c, h.activity, h.logger, activity.Event{
+		Type:      activity.TypeFeatureCreated,
+		ActorID:   &userID,
+		FeatureID: createdFeature.ID,
+	})
+
 	h.logger.Info("Feature created successfully",
 		logs.WithUserID(userID),
 		logs.WithFeatureID(createdFeature.ID),
 		logs.WithVoteCount(createdFeature.VoteCount),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusCreated),
 		logs.WithMetadata("feature_title", createdFeature.Title))
 
+	createdFeature.MarkFreshness(h.newWindow, time.Now())
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "Feature created successfully",
 		"feature": createdFeature,
@@ -122,29 +399,68 @@ func (h *FeatureHandler) CreateFeature(c *gin.Context) {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
+// @Param sort query string false "Sort order: votes, newest or oldest" default(votes)
+// @Param status query string false "Only features in this status" Enums(open, planned, in_progress, completed, rejected)
+// @Param tag query string false "Only features with this tag"
+// @Param created_after query string false "Only features created at or after this RFC3339 time"
+// @Param created_before query string false "Only features created at or before this RFC3339 time"
 // @Success 200 {object} features.FeatureListResponse "List of features"
+// @Header 200 {string} Link "RFC 5988 first, prev, next and last page links"
 // @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /features [get]
 func (h *FeatureHandler) GetFeatures(c *gin.Context) {
 	h.logger.Info("Get features request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
-	// Parse pagination parameters
-	page := 1
-	perPage := 10
+	page, perPage := parsePagination(c)
 
-	if pageStr := c.Query("page"); pageStr != "" {
-		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = p
-		}
+	sortStr := c.DefaultQuery("sort", string(features.SortVotes))
+	sort, ok := features.ParseSortOrder(sortStr)
+	if !ok {
+		h.logger.Warning("Invalid feature sort order",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("sort", sortStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort must be one of votes, newest, oldest"})
+		return
 	}
 
-	if perPageStr := c.Query("per_page"); perPageStr != "" {
-		if pp, err := strconv.Atoi(perPageStr); err == nil && pp > 0 && pp <= 100 {
-			perPage = pp
+	status := c.Query("status")
+	if status != "" && !features.ValidStatus(status) {
+		h.logger.Warning("Invalid feature status filter",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("status", status))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be one of open, planned, in_progress, completed, rejected"})
+		return
+	}
+
+	tag := c.Query("tag")
+	if tag != "" {
+		normalized, err := features.NormalizeTag(tag)
+		if err != nil {
+			h.logger.Warning("Invalid feature tag filter",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("tag", tag))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
 		}
+		tag = normalized
+	}
+
+	createdAfter, createdBefore, ok := h.parseCreatedRange(c)
+	if !ok {
+		return
 	}
 
 	// Get optional user ID for vote status
@@ -153,33 +469,41 @@ func (h *FeatureHandler) GetFeatures(c *gin.Context) {
 	logFields := []logs.LogField{
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithMetadata("page", page),
 		logs.WithMetadata("per_page", perPage),
+		logs.WithMetadata("sort", sort),
+		logs.WithMetadata("status", status),
+		logs.WithMetadata("tag", tag),
 	}
 	if userID != nil {
 		logFields = append(logFields, logs.WithUserID(*userID))
 	}
+	if createdAfter != nil {
+		logFields = append(logFields, logs.WithMetadata("created_after", *createdAfter))
+	}
+	if createdBefore != nil {
+		logFields = append(logFields, logs.WithMetadata("created_before", *createdBefore))
+	}
 
 	h.logger.Debug("Fetching features with pagination", logFields...)
 
-	featuresList, total, err := h.featureRepo.GetAll(page, perPage, userID)
+	featuresList, total, err := h.featureRepo.GetAll(page, perPage, userID, sort, status, tag, createdAfter, createdBefore)
 	if err != nil {
 		h.logger.Error("Failed to get features from database", err,
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError),
 			logs.WithMetadata("page", page),
 			logs.WithMetadata("per_page", perPage))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get features"})
 		return
 	}
+	h.applyVoteCountVisibility(featuresList, userID, c.GetString("role"))
+	h.applyFreshness(featuresList)
 
-	response := features.FeatureListResponse{
-		Features: featuresList,
-		Total:    total,
-		Page:     page,
-		PerPage:  perPage,
-	}
+	response := features.NewFeatureListResponse(featuresList, total, page, perPage)
 
 	logFields = append(logFields,
 		logs.WithStatusCode(http.StatusOK),
@@ -188,9 +512,110 @@ func (h *FeatureHandler) GetFeatures(c *gin.Context) {
 
 	h.logger.Info("Features retrieved successfully", logFields...)
 
+	if h.linkHeaders {
+		setPaginationLinks(c, page, perPage, total)
+	}
 	c.JSON(http.StatusOK, response)
 }
 
+// parseCreatedRange reads the optional created_after and created_before RFC3339 bounds,
+// converted to UTC, writing a 400 response and returning false when either is invalid
+func (h *FeatureHandler) parseCreatedRange(c *gin.Context) (*time.Time, *time.Time, bool) {
+	var bounds [2]*time.Time
+	for i, param := range []string{"created_after", "created_before"} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			h.logger.Warning("Invalid feature creation time filter",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata(param, value))
+			c.JSON(http.StatusBadRequest, gin.H{"error": param + " must be an RFC3339 timestamp"})
+			return nil, nil, false
+		}
+		t = t.UTC()
+		bounds[i] = &t
+	}
+
+	if bounds[0] != nil && bounds[1] != nil && bounds[0].After(*bounds[1]) {
+		h.logger.Warning("Empty feature creation time range",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "created_after must not be later than created_before"})
+		return nil, nil, false
+	}
+
+	return bounds[0], bounds[1], true
+}
+
+// SearchFeatures godoc
+// @Summary Search features
+// @Description Get a paginated list of features whose title or description contains the query, case-insensitively
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search text"
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} features.FeatureListResponse "Matching features"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/search [get]
+func (h *FeatureHandler) SearchFeatures(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		h.logger.Warning("Feature search without a query",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Search query is required"})
+		return
+	}
+
+	page, perPage := parsePagination(c)
+	userID := getOptionalUserID(c)
+
+	featuresList, total, err := h.featureRepo.SearchFeatures(query, page, perPage, userID)
+	if err != nil {
+		h.logger.Error("Failed to search features in database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("query", query))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search features"})
+		return
+	}
+	if featuresList == nil {
+		featuresList = []features.Feature{}
+	}
+	h.applyVoteCountVisibility(featuresList, userID, c.GetString("role"))
+	h.applyFreshness(featuresList)
+
+	h.logger.Debug("Feature search completed",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("query", query),
+		logs.WithMetadata("total_features", total),
+		logs.WithMetadata("returned_count", len(featuresList)))
+
+	if h.linkHeaders {
+		setPaginationLinks(c, page, perPage, total)
+	}
+	c.JSON(http.StatusOK, features.NewFeatureListResponse(featuresList, total, page, perPage))
+}
+
 // GetFeature godoc
 // @Summary Get a feature by ID
 // @Description Get detailed information about a specific feature
@@ -207,7 +632,8 @@ func (h *FeatureHandler) GetFeatures(c *gin.Context) {
 func (h *FeatureHandler) GetFeature(c *gin.Context) {
 	h.logger.Info("Get single feature request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
@@ -215,6 +641,7 @@ func (h *FeatureHandler) GetFeature(c *gin.Context) {
 		h.logger.Warning("Invalid feature ID provided",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest),
 			logs.WithMetadata("provided_id", idStr))
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
@@ -228,6 +655,7 @@ func (h *FeatureHandler) GetFeature(c *gin.Context) {
 		logs.WithFeatureID(id),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 	}
 	if userID != nil {
 		logFields = append(logFields, logs.WithUserID(*userID))
@@ -242,6 +670,7 @@ func (h *FeatureHandler) GetFeature(c *gin.Context) {
 				logs.WithFeatureID(id),
 				logs.WithMethod(c.Request.Method),
 				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
 				logs.WithStatusCode(http.StatusNotFound))
 			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
 			return
@@ -250,16 +679,36 @@ func (h *FeatureHandler) GetFeature(c *gin.Context) {
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
 		return
 	}
+	if h.hideVoteCounts {
+		feature.HideVoteCountFrom(userID, c.GetString("role"))
+	}
+	if h.categoryCounts && !feature.VoteCountHidden {
+		counts, err := h.featureRepo.GetCategoryCounts(id)
+		if err != nil {
+			h.logger.Error("Failed to get vote category counts from database", err,
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
+			return
+		}
+		feature.CategoryCounts = counts
+	}
+	feature.MarkFreshness(h.newWindow, time.Now())
 
 	h.logger.Info("Feature retrieved successfully",
 		logs.WithFeatureID(feature.ID),
 		logs.WithVoteCount(feature.VoteCount),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK),
 		logs.WithMetadata("feature_title", feature.Title),
 		logs.WithMetadata("created_by", feature.CreatedBy))
@@ -269,119 +718,953 @@ func (h *FeatureHandler) GetFeature(c *gin.Context) {
 	})
 }
 
-// UpdateFeature godoc
-// @Summary Update a feature
-// @Description Update an existing feature (only by creator)
+// CompareFeatures godoc
+// @Summary Compare two features side by side
+// @Description Get two features with their vote counts and the viewer's vote status for each
 // @Tags features
 // @Accept json
 // @Produce json
-// @Security BearerAuth
-// @Param id path int true "Feature ID"
-// @Param feature body features.UpdateFeatureRequest true "Updated feature data"
-// @Success 200 {object} features.Feature "Updated feature"
+// @Param ids query string true "Two comma-separated feature IDs, e.g. 3,7"
+// @Success 200 {object} map[string]interface{} "Both features in the requested order"
 // @Failure 400 {object} map[string]interface{} "Bad request"
-// @Failure 401 {object} map[string]interface{} "Unauthorized"
-// @Failure 403 {object} map[string]interface{} "Forbidden"
 // @Failure 404 {object} map[string]interface{} "Feature not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
-// @Router /features/{id} [put]
-func (h *FeatureHandler) UpdateFeature(c *gin.Context) {
-	h.logger.Info("Update feature request started",
-		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
-
-	idStr := c.Param("id")
-	id, err := strconv.Atoi(idStr)
-	if err != nil {
-		h.logger.Warning("Invalid feature ID for update",
+// @Router /features/compare [get]
+func (h *FeatureHandler) CompareFeatures(c *gin.Context) {
+	idsStr := c.Query("ids")
+	parts := strings.Split(idsStr, ",")
+	if len(parts) != 2 {
+		h.logger.Warning("Compare features requested without exactly two IDs",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest),
-			logs.WithMetadata("provided_id", idStr))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+			logs.WithMetadata("ids", idsStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ids must contain exactly two feature IDs"})
 		return
 	}
 
-	userID, exists := getUserID(c)
-	if !exists {
-		h.logger.Warning("Update feature attempt without authentication",
-			logs.WithFeatureID(id),
-			logs.WithMethod(c.Request.Method),
-			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusUnauthorized))
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+	ids := make([]int, 0, 2)
+	for _, part := range parts {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || id < 1 {
+			h.logger.Warning("Invalid feature ID for comparison",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("ids", idsStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+			return
+		}
+		ids = append(ids, id)
+	}
+	if ids[0] == ids[1] {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ids must contain two different feature IDs"})
 		return
 	}
 
-	var req features.UpdateFeatureRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("Update feature request validation failed", err,
-			logs.WithUserID(userID),
-			logs.WithFeatureID(id),
+	userID := getOptionalUserID(c)
+
+	found, err := h.featureRepo.GetByIDs(ids, userID)
+	if err != nil {
+		h.logger.Error("Failed to get features for comparison", err,
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusBadRequest))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("ids", ids))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get features"})
 		return
 	}
 
-	logFields := []logs.LogField{
-		logs.WithUserID(userID),
-		logs.WithFeatureID(id),
-		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path),
-	}
-	if req.Title != nil {
-		logFields = append(logFields, logs.WithMetadata("new_title", *req.Title))
-	}
-	if req.Description != nil {
-		logFields = append(logFields, logs.WithMetadata("description_length", len(*req.Description)))
+	// Return the features in the order they were requested
+	byID := make(map[int]features.Feature, len(found))
+	for _, f := range found {
+		byID[f.ID] = f
 	}
-
-	h.logger.Info("Processing feature update request", logFields...)
-
-	// Check if feature exists and user is the creator
-	feature, err := h.featureRepo.GetByID(id, nil)
-	if err != nil {
-		if err.Error() == "feature not found" {
-			h.logger.Info("Update attempt on non-existent feature",
-				logs.WithUserID(userID),
+	h.applyVoteCountVisibility(found, userID, c.GetString("role"))
+	h.applyFreshness(found)
+	compared := make([]features.Feature, 0, 2)
+	for _, id := range ids {
+		f, ok := byID[id]
+		if !ok {
+			h.logger.Info("Compare requested for non-existent feature",
 				logs.WithFeatureID(id),
 				logs.WithMethod(c.Request.Method),
 				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
 				logs.WithStatusCode(http.StatusNotFound))
-			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+			c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("Feature %d not found", id)})
 			return
 		}
-		h.logger.Error("Failed to get feature for update validation", err,
-			logs.WithUserID(userID),
-			logs.WithFeatureID(id),
-			logs.WithMethod(c.Request.Method),
-			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusInternalServerError))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
-		return
+		compared = append(compared, f)
 	}
 
-	if feature.CreatedBy != userID {
-		h.logger.Warning("Unauthorized feature update attempt",
-			logs.WithUserID(userID),
+	c.JSON(http.StatusOK, gin.H{
+		"features": compared,
+	})
+}
+
+// GetTopFeatures godoc
+// @Summary Get the vote leaderboard for a time window
+// @Description Get the most-voted features, counting only votes cast in the last week or month, or all-time votes
+// @Tags features
+// @Accept json
+// @Produce json
+// @Param window query string false "Time window" Enums(week, month, all) default(all)
+// @Param limit query int false "Maximum number of features" default(10)
+// @Success 200 {object} map[string]interface{} "Ranked features"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/top [get]
+func (h *FeatureHandler) GetTopFeatures(c *gin.Context) {
+	windowStr := c.DefaultQuery("window", string(features.WindowAll))
+	window, ok := features.ParseLeaderboardWindow(windowStr)
+	if !ok {
+		h.logger.Warning("Invalid leaderboard window",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("window", windowStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "window must be one of week, month, all"})
+		return
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > 100 {
+			h.logger.Warning("Invalid leaderboard limit",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("limit", limitStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer between 1 and 100"})
+			return
+		}
+		limit = l
+	}
+
+	ranked, err := h.featureRepo.GetTop(window.Since(time.Now()), limit)
+	if err != nil {
+		h.logger.Error("Failed to get top features from database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("window", window))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get top features"})
+		return
+	}
+	if ranked == nil {
+		ranked = []features.RankedFeature{}
+	}
+	if h.hideVoteCounts {
+		viewerID := getOptionalUserID(c)
+		for i := range ranked {
+			ranked[i].HideVoteCountFrom(viewerID, c.GetString("role"))
+		}
+	}
+
+	h.logger.Debug("Top features retrieved",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("window", window),
+		logs.WithMetadata("returned_count", len(ranked)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"features": ranked,
+		"window":   window,
+		"limit":    limit,
+	})
+}
+
+// GetSurgingFeatures godoc
+// @Summary Get features with surging demand
+// @Description Get features whose votes in the last 24 hours exceed their prior daily average by the configured multiplier
+// @Tags features
+// @Accept json
+// @Produce json
+// @Param limit query int false "Maximum number of features" default(10)
+// @Success 200 {object} map[string]interface{} "Surging features"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/surging [get]
+func (h *FeatureHandler) GetSurgingFeatures(c *gin.Context) {
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > 100 {
+			h.logger.Warning("Invalid surging features limit",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("limit", limitStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer between 1 and 100"})
+			return
+		}
+		limit = l
+	}
+
+	surging, err := h.featureRepo.GetSurgingFeatures(h.surgeMultiplier, limit)
+	if err != nil {
+		h.logger.Error("Failed to get surging features from database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("multiplier", h.surgeMultiplier))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get surging features"})
+		return
+	}
+	if surging == nil {
+		surging = []features.SurgingFeature{}
+	}
+	if h.hideVoteCounts {
+		viewerID := getOptionalUserID(c)
+		for i := range surging {
+			surging[i].HideVoteCountFrom(viewerID, c.GetString("role"))
+		}
+	}
+
+	h.logger.Debug("Surging features retrieved",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("multiplier", h.surgeMultiplier),
+		logs.WithMetadata("returned_count", len(surging)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"features":   surging,
+		"multiplier": h.surgeMultiplier,
+		"limit":      limit,
+	})
+}
+
+// Trending window bounds
+const (
+	defaultTrendingWindow = 48 * time.Hour
+	maxTrendingWindow     = 30 * 24 * time.Hour
+)
+
+// GetTrendingFeatures godoc
+// @Summary Get trending features
+// @Description Get the features that received the most votes within a recent window, with both their windowed and total vote counts
+// @Tags features
+// @Accept json
+// @Produce json
+// @Param window query string false "Go duration of the window, up to 720h" default(48h)
+// @Param limit query int false "Maximum number of features" default(10)
+// @Success 200 {object} map[string]interface{} "Trending features"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/trending [get]
+func (h *FeatureHandler) GetTrendingFeatures(c *gin.Context) {
+	window := defaultTrendingWindow
+	if windowStr := c.Query("window"); windowStr != "" {
+		w, err := time.ParseDuration(windowStr)
+		if err != nil || w <= 0 || w > maxTrendingWindow {
+			h.logger.Warning("Invalid trending window",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("window", windowStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "window must be a positive duration of at most 720h"})
+			return
+		}
+		window = w
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > 100 {
+			h.logger.Warning("Invalid trending features limit",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("limit", limitStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer between 1 and 100"})
+			return
+		}
+		limit = l
+	}
+
+	since := time.Now().Add(-window)
+	trending, err := h.featureRepo.GetTop(&since, limit)
+	if err != nil {
+		h.logger.Error("Failed to get trending features from database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("window", window.String()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get trending features"})
+		return
+	}
+	if trending == nil {
+		trending = []features.RankedFeature{}
+	}
+	if h.hideVoteCounts {
+		viewerID := getOptionalUserID(c)
+		for i := range trending {
+			trending[i].HideVoteCountFrom(viewerID, c.GetString("role"))
+		}
+	}
+
+	h.logger.Debug("Trending features retrieved",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("window", window.String()),
+		logs.WithMetadata("returned_count", len(trending)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"features": trending,
+		"window":   window.String(),
+		"limit":    limit,
+	})
+}
+
+// GetTeamPicks godoc
+// @Summary Get features most voted by the viewer's teammates
+// @Description Rank features by how many of the authenticated user's teammates voted for them
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Maximum number of features" default(10)
+// @Success 200 {object} map[string]interface{} "Features with teammate vote counts"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/team-picks [get]
+func (h *FeatureHandler) GetTeamPicks(c *gin.Context) {
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get team picks attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > 100 {
+			h.logger.Warning("Invalid team picks limit",
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("limit", limitStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer between 1 and 100"})
+			return
+		}
+		limit = l
+	}
+
+	picks, err := h.featureRepo.GetTeamPicks(userID, limit)
+	if err != nil {
+		if err.Error() == "user has no team" {
+			h.logger.Debug("Team picks requested by user without a team",
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusOK))
+			c.JSON(http.StatusOK, gin.H{
+				"features": []features.TeamPick{},
+				"has_team": false,
+				"limit":    limit,
+			})
+			return
+		}
+		h.logger.Error("Failed to get team picks from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get team picks"})
+		return
+	}
+	if picks == nil {
+		picks = []features.TeamPick{}
+	}
+	if h.hideVoteCounts {
+		for i := range picks {
+			picks[i].HideVoteCountFrom(&userID, c.GetString("role"))
+		}
+	}
+
+	h.logger.Debug("Team picks retrieved",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("returned_count", len(picks)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"features": picks,
+		"has_team": true,
+		"limit":    limit,
+	})
+}
+
+// GetAlsoVoted godoc
+// @Summary Get features also voted for by a feature's voters
+// @Description Get the other features most commonly voted for by users who voted for this feature, with co-occurrence counts
+// @Tags features
+// @Accept json
+// @Produce json
+// @Param id path int true "Feature ID"
+// @Param limit query int false "Maximum number of features" default(10)
+// @Success 200 {object} map[string]interface{} "Co-voted features"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/also-voted [get]
+func (h *FeatureHandler) GetAlsoVoted(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for also-voted",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > 100 {
+			h.logger.Warning("Invalid also-voted limit",
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("limit", limitStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer between 1 and 100"})
+			return
+		}
+		limit = l
+	}
+
+	exists, err := h.featureRepo.FeatureExists(id)
+	if err != nil {
+		h.logger.Error("Failed to check if feature exists", err,
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get co-voted features"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	coVoted, err := h.featureRepo.GetCoVotedFeatures(id, limit)
+	if err != nil {
+		h.logger.Error("Failed to get co-voted features from database", err,
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get co-voted features"})
+		return
+	}
+	if coVoted == nil {
+		coVoted = []features.CoVotedFeature{}
+	}
+	if h.hideVoteCounts {
+		for i := range coVoted {
+			coVoted[i].HideVoteCountFrom(getOptionalUserID(c), c.GetString("role"))
+		}
+	}
+
+	h.logger.Debug("Co-voted features retrieved",
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("returned_count", len(coVoted)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"feature_id": id,
+		"features":   coVoted,
+		"limit":      limit,
+	})
+}
+
+// GetVoteDelta godoc
+// @Summary Get vote count change since a baseline
+// @Description Get a feature's current vote count and its delta from a client-provided baseline, for lightweight polling
+// @Tags features
+// @Accept json
+// @Produce json
+// @Param id path int true "Feature ID"
+// @Param since_count query int false "Vote count previously seen by the client" default(0)
+// @Success 200 {object} map[string]interface{} "Current vote count and delta"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/vote-delta [get]
+func (h *FeatureHandler) GetVoteDelta(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for vote delta",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	// A missing baseline means the client has not seen any count yet
+	sinceCount := 0
+	if sinceStr := c.Query("since_count"); sinceStr != "" {
+		sc, err := strconv.Atoi(sinceStr)
+		if err != nil || sc < 0 {
+			h.logger.Warning("Invalid since_count for vote delta",
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("since_count", sinceStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "since_count must be a non-negative integer"})
+			return
+		}
+		sinceCount = sc
+	}
+
+	userID := getOptionalUserID(c)
+	feature, err := h.featureRepo.GetByID(id, userID)
+	if err != nil {
+		if err.Error() == "feature not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+			return
+		}
+		h.logger.Error("Failed to get feature for vote delta", err,
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
+		return
+	}
+
+	if h.hideVoteCounts {
+		feature.HideVoteCountFrom(userID, c.GetString("role"))
+		if feature.VoteCountHidden {
+			c.JSON(http.StatusOK, gin.H{
+				"feature_id":        id,
+				"vote_count_hidden": true,
+			})
+			return
+		}
+	}
+
+	delta := feature.VoteCount - sinceCount
+
+	h.logger.Debug("Vote delta computed",
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithVoteCount(feature.VoteCount),
+		logs.WithMetadata("since_count", sinceCount),
+		logs.WithMetadata("delta", delta))
+
+	c.JSON(http.StatusOK, gin.H{
+		"feature_id":  id,
+		"vote_count":  feature.VoteCount,
+		"since_count": sinceCount,
+		"delta":       delta,
+		"changed":     delta != 0,
+	})
+}
+
+// Rank history window bounds, in days
+const (
+	defaultRankHistoryDays = 30
+	maxRankHistoryDays     = 365
+)
+
+// GetRankHistory godoc
+// @Summary Get a feature's daily rank history
+// @Description Get the feature's rank among all features for each day in the window, oldest first. Ranks come from daily vote count snapshots, so days before snapshots were taken are missing; tied features share a rank.
+// @Tags features
+// @Accept json
+// @Produce json
+// @Param id path int true "Feature ID"
+// @Param days query int false "Number of days to look back (max 365)" default(30)
+// @Success 200 {object} map[string]interface{} "Daily rank history"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/rank-history [get]
+func (h *FeatureHandler) GetRankHistory(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for rank history",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	days := defaultRankHistoryDays
+	if daysStr := c.Query("days"); daysStr != "" {
+		d, err := strconv.Atoi(daysStr)
+		if err != nil || d < 1 || d > maxRankHistoryDays {
+			h.logger.Warning("Invalid days for rank history",
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("days", daysStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("days must be between 1 and %d", maxRankHistoryDays)})
+			return
+		}
+		days = d
+	}
+
+	exists, err := h.featureRepo.FeatureExists(id)
+	if err != nil {
+		h.logger.Error("Failed to check feature existence for rank history", err,
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	history, err := h.featureRepo.GetRankHistory(id, days)
+	if err != nil {
+		h.logger.Error("Failed to get rank history from database", err,
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get rank history"})
+		return
+	}
+	if history == nil {
+		history = []features.RankPoint{}
+	}
+
+	h.logger.Debug("Rank history retrieved",
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("days", days),
+		logs.WithMetadata("returned_count", len(history)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"feature_id": id,
+		"days":       days,
+		"history":    history,
+	})
+}
+
+// UpdateFeature godoc
+// @Summary Replace a feature
+// @Description Replace an existing feature's title and description (only by creator). Both fields are required.
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param feature body features.ReplaceFeatureRequest true "Full feature data"
+// @Success 200 {object} features.Feature "Updated feature"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 429 {object} map[string]interface{} "Edited too recently"
+// @Failure 422 {object} map[string]interface{} "Validation failed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id} [put]
+func (h *FeatureHandler) UpdateFeature(c *gin.Context) {
+	h.updateFeature(c, true)
+}
+
+// PatchFeature godoc
+// @Summary Partially update a feature
+// @Description Update only the provided fields of an existing feature (only by creator)
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param feature body features.UpdateFeatureRequest true "Fields to update"
+// @Success 200 {object} features.Feature "Updated feature"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 429 {object} map[string]interface{} "Edited too recently"
+// @Failure 422 {object} map[string]interface{} "Validation failed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id} [patch]
+func (h *FeatureHandler) PatchFeature(c *gin.Context) {
+	h.updateFeature(c, false)
+}
+
+// updateFeature runs the shared ownership, validation and persistence steps for
+// PUT and PATCH; replace requires the full resource in the request body
+func (h *FeatureHandler) updateFeature(c *gin.Context, replace bool) {
+	h.logger.Info("Update feature request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for update",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Update feature attempt without authentication",
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req features.UpdateFeatureRequest
+	var full features.ReplaceFeatureRequest
+	var bound interface{} = &req
+	if replace {
+		bound = &full
+	}
+	if err := c.ShouldBindJSON(bound); err != nil {
+		h.logger.Error("Update feature request validation failed", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, bound))
+		return
+	}
+	if replace {
+		req = full.ToUpdate()
+	}
+
+	if msg := h.lengthViolation(req.Title, req.Description); msg != "" {
+		h.logger.Warning("Update feature request exceeds length limits",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if msg := h.qualityViolation(req.Description); msg != "" {
+		h.logger.Warning("Update feature request description fails quality check",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if msg := expiryViolation(req.ExpiresAt); msg != "" {
+		h.logger.Warning("Update feature request has an expiry in the past",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	logFields := []logs.LogField{
+		logs.WithUserID(userID),
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+	}
+	if req.Title != nil {
+		logFields = append(logFields, logs.WithMetadata("new_title", *req.Title))
+	}
+	if req.Description != nil {
+		logFields = append(logFields, logs.WithMetadata("description_length", len(*req.Description)))
+	}
+
+	h.logger.Info("Processing feature update request", logFields...)
+
+	// Check if feature exists and user is the creator
+	feature, err := h.featureRepo.GetByID(id, nil)
+	if err != nil {
+		if err.Error() == "feature not found" {
+			h.logger.Info("Update attempt on non-existent feature",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+			return
+		}
+		h.logger.Error("Failed to get feature for update validation", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
+		return
+	}
+
+	if feature.CreatedBy != userID && !isAdmin(c) {
+		h.logger.Warning("Unauthorized feature update attempt",
+			logs.WithUserID(userID),
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusForbidden),
 			logs.WithMetadata("feature_owner_id", feature.CreatedBy))
 		c.JSON(http.StatusForbidden, gin.H{"error": "You can only update your own features"})
 		return
 	}
 
+	// Compare against the stored value for whichever field is not being changed
+	newTitle, newDescription := feature.Title, feature.Description
+	if req.Title != nil {
+		newTitle = *req.Title
+	}
+	if req.Description != nil {
+		newDescription = *req.Description
+	}
+	if msg := h.contentViolation(newTitle, newDescription); msg != "" {
+		h.logger.Warning("Update feature request description repeats title",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if msg := h.changeViolation(feature.Description, req.Description); msg != "" {
+		h.logger.Warning("Update feature request changes too little of the description",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": msg})
+		return
+	}
+
+	if h.minEditInterval > 0 {
+		if wait := h.minEditInterval - time.Since(feature.UpdatedAt); wait > 0 {
+			retryAfter := int(wait.Seconds())
+			if wait > time.Duration(retryAfter)*time.Second {
+				retryAfter++
+			}
+			h.logger.Info("Feature edit rejected inside minimum edit interval",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusTooManyRequests),
+				logs.WithMetadata("retry_after_seconds", retryAfter))
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Feature was edited too recently, please try again later"})
+			return
+		}
+	}
+
 	// Update feature
-	if err := h.featureRepo.Update(id, req.Title, req.Description); err != nil {
+	if err := h.featureRepo.Update(id, req.Title, req.Description, req.ExpiresAt); err != nil {
+		if isLengthError(err) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to update feature in database", err,
 			logs.WithUserID(userID),
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update feature"})
 		return
@@ -395,6 +1678,7 @@ func (h *FeatureHandler) UpdateFeature(c *gin.Context) {
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated feature"})
 		return
@@ -406,19 +1690,161 @@ func (h *FeatureHandler) UpdateFeature(c *gin.Context) {
 		logs.WithVoteCount(updatedFeature.VoteCount),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("updated_title", updatedFeature.Title),
+		logs.WithMetadata("description_length", len(updatedFeature.Description)))
+
+	updatedFeature.MarkFreshness(h.newWindow, time.Now())
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Feature updated successfully",
+		"feature": updatedFeature,
+	})
+}
+
+// UpdateFeatureStatus godoc
+// @Summary Change a feature's lifecycle status
+// @Description Move a feature to open, planned, in_progress, completed or rejected (creator or admin only)
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param request body features.UpdateStatusRequest true "New status"
+// @Success 200 {object} map[string]interface{} "Feature status updated"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/status [patch]
+func (h *FeatureHandler) UpdateFeatureStatus(c *gin.Context) {
+	h.logger.Info("Update feature status request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for status update",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Update feature status attempt without authentication",
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req features.UpdateStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warning("Invalid feature status request",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("validation_error", err.Error()))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+	if !features.ValidStatus(req.Status) {
+		h.logger.Warning("Unknown feature status requested",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("status", req.Status))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be one of open, planned, in_progress, completed, rejected"})
+		return
+	}
+
+	feature, err := h.featureRepo.GetByID(id, nil)
+	if err != nil {
+		if err.Error() == "feature not found" {
+			h.logger.Info("Status update attempt on non-existent feature",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+			return
+		}
+		h.logger.Error("Failed to get feature for status update", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
+		return
+	}
+
+	if feature.CreatedBy != userID && !isAdmin(c) {
+		h.logger.Warning("Unauthorized feature status update attempt",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusForbidden),
+			logs.WithMetadata("feature_owner_id", feature.CreatedBy))
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only change the status of your own features"})
+		return
+	}
+
+	if err := h.featureRepo.UpdateStatus(id, req.Status); err != nil {
+		h.logger.Error("Failed to update feature status in database", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("status", req.Status))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update feature status"})
+		return
+	}
+
+	h.logger.Info("Feature status updated successfully",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK),
-		logs.WithMetadata("updated_title", updatedFeature.Title),
-		logs.WithMetadata("description_length", len(updatedFeature.Description)))
+		logs.WithMetadata("previous_status", feature.Status),
+		logs.WithMetadata("status", req.Status))
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Feature updated successfully",
-		"feature": updatedFeature,
+		"message": "Feature status updated successfully",
+		"id":      id,
+		"status":  req.Status,
 	})
 }
 
 // DeleteFeature godoc
 // @Summary Delete a feature
-// @Description Delete an existing feature (only by creator)
+// @Description Delete an existing feature (only by creator or an admin); its votes are kept so an admin can restore it
 // @Tags features
 // @Accept json
 // @Produce json
@@ -434,7 +1860,8 @@ func (h *FeatureHandler) UpdateFeature(c *gin.Context) {
 func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 	h.logger.Info("Delete feature request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
@@ -442,6 +1869,7 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 		h.logger.Warning("Invalid feature ID for deletion",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest),
 			logs.WithMetadata("provided_id", idStr))
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
@@ -454,6 +1882,7 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
@@ -463,7 +1892,8 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 		logs.WithUserID(userID),
 		logs.WithFeatureID(id),
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	// Check if feature exists and user is the creator
 	feature, err := h.featureRepo.GetByID(id, nil)
@@ -474,6 +1904,7 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 				logs.WithFeatureID(id),
 				logs.WithMethod(c.Request.Method),
 				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
 				logs.WithStatusCode(http.StatusNotFound))
 			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
 			return
@@ -483,17 +1914,19 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
 		return
 	}
 
-	if feature.CreatedBy != userID {
+	if feature.CreatedBy != userID && !isAdmin(c) {
 		h.logger.Warning("Unauthorized feature deletion attempt",
 			logs.WithUserID(userID),
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusForbidden),
 			logs.WithMetadata("feature_owner_id", feature.CreatedBy),
 			logs.WithMetadata("feature_title", feature.Title))
@@ -508,6 +1941,7 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 			logs.WithFeatureID(id),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError),
 			logs.WithMetadata("feature_title", feature.Title))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete feature"})
@@ -519,6 +1953,7 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 		logs.WithFeatureID(id),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK),
 		logs.WithMetadata("deleted_title", feature.Title),
 		logs.WithMetadata("deleted_vote_count", feature.VoteCount))
@@ -528,6 +1963,345 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 	})
 }
 
+// RestoreFeature godoc
+// @Summary Restore a deleted feature
+// @Description Undo the deletion of a feature, bringing back its votes and comments (admin only)
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Success 200 {object} map[string]interface{} "Restored feature"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "Deleted feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/restore [post]
+func (h *FeatureHandler) RestoreFeature(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for restore",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, _ := getUserID(c)
+
+	if err := h.featureRepo.Restore(id); err != nil {
+		if err.Error() == "feature not found" {
+			h.logger.Info("Restore attempt on a feature that is not deleted",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Deleted feature not found"})
+			return
+		}
+		h.logger.Error("Failed to restore feature in database", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore feature"})
+		return
+	}
+
+	feature, err := h.featureRepo.GetByID(id, nil)
+	if err != nil {
+		h.logger.Error("Failed to get restored feature", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
+		return
+	}
+
+	h.logger.Info("Feature restored successfully",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("vote_count", feature.VoteCount))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Feature restored successfully",
+		"feature": feature,
+	})
+}
+
+// AddFeatureTags godoc
+// @Summary Tag a feature
+// @Description Add tags to a feature (only by creator or an admin); tags are lower-cased, and ones the feature already has are ignored
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param tags body features.AddTagsRequest true "Tags to add"
+// @Success 200 {object} map[string]interface{} "The feature's tags"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 422 {object} map[string]interface{} "Too many tags"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/tags [post]
+func (h *FeatureHandler) AddFeatureTags(c *gin.Context) {
+	id, userID, ok := h.authorizeTagChange(c)
+	if !ok {
+		return
+	}
+
+	var req features.AddTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warning("Invalid feature tags request",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+
+	tags, err := features.NormalizeTags(req.Tags)
+	if err != nil {
+		h.logger.Warning("Invalid feature tag",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("tags", req.Tags))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	existing, err := h.featureRepo.GetTags(id)
+	if err != nil {
+		h.logger.Error("Failed to get feature tags", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to tag feature"})
+		return
+	}
+
+	// Only tags the feature does not have yet count towards the limit
+	total, _ := features.NormalizeTags(append(existing, tags...))
+	if len(total) > features.MaxTagsPerFeature {
+		h.logger.Info("Feature tag limit reached",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity),
+			logs.WithMetadata("tag_count", len(total)))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": fmt.Sprintf("A feature can have at most %d tags", features.MaxTagsPerFeature),
+		})
+		return
+	}
+
+	for _, tag := range tags {
+		if err := h.featureRepo.AddTag(id, tag); err != nil {
+			h.logger.Error("Failed to add feature tag", err,
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError),
+				logs.WithMetadata("tag", tag))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to tag feature"})
+			return
+		}
+	}
+
+	h.respondWithTags(c, id, userID, "Feature tagged successfully")
+}
+
+// RemoveFeatureTag godoc
+// @Summary Untag a feature
+// @Description Remove a tag from a feature (only by creator or an admin)
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param tag path string true "Tag"
+// @Success 200 {object} map[string]interface{} "The feature's remaining tags"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "Feature or tag not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/tags/{tag} [delete]
+func (h *FeatureHandler) RemoveFeatureTag(c *gin.Context) {
+	id, userID, ok := h.authorizeTagChange(c)
+	if !ok {
+		return
+	}
+
+	tag, err := features.NormalizeTag(c.Param("tag"))
+	if err != nil {
+		h.logger.Warning("Invalid feature tag",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("tag", c.Param("tag")))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.featureRepo.RemoveTag(id, tag); err != nil {
+		if err.Error() == "tag not found" {
+			h.logger.Info("Remove attempt on a tag the feature does not have",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound),
+				logs.WithMetadata("tag", tag))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+			return
+		}
+		h.logger.Error("Failed to remove feature tag", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("tag", tag))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove tag"})
+		return
+	}
+
+	h.respondWithTags(c, id, userID, "Feature tag removed successfully")
+}
+
+// authorizeTagChange parses the feature ID and checks the feature exists and the caller may
+// change its tags, writing the error response and returning false if not
+func (h *FeatureHandler) authorizeTagChange(c *gin.Context) (int, int, bool) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for tagging",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return 0, 0, false
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Feature tagging attempt without authentication",
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return 0, 0, false
+	}
+
+	feature, err := h.featureRepo.GetByID(id, nil)
+	if err != nil {
+		if err.Error() == "feature not found" {
+			h.logger.Info("Tagging attempt on non-existent feature",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+			return 0, 0, false
+		}
+		h.logger.Error("Failed to get feature for tagging", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature"})
+		return 0, 0, false
+	}
+
+	if feature.CreatedBy != userID && !isAdmin(c) {
+		h.logger.Warning("Unauthorized feature tagging attempt",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusForbidden),
+			logs.WithMetadata("feature_owner_id", feature.CreatedBy))
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only tag your own features"})
+		return 0, 0, false
+	}
+
+	return id, userID, true
+}
+
+// respondWithTags replies with the feature's current tags after a tag change
+func (h *FeatureHandler) respondWithTags(c *gin.Context, id, userID int, message string) {
+	tags, err := h.featureRepo.GetTags(id)
+	if err != nil {
+		h.logger.Error("Failed to get feature tags", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature tags"})
+		return
+	}
+
+	h.logger.Info(message,
+		logs.WithUserID(userID),
+		logs.WithFeatureID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("tags", tags))
+
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
 // GetMyFeatures godoc
 // @Summary Get user's features
 // @Description Get all features created by the authenticated user
@@ -542,13 +2316,15 @@ func (h *FeatureHandler) DeleteFeature(c *gin.Context) {
 func (h *FeatureHandler) GetMyFeatures(c *gin.Context) {
 	h.logger.Info("Get my features request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	userID, exists := getUserID(c)
 	if !exists {
 		h.logger.Warning("Get my features attempt without authentication",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
@@ -557,7 +2333,8 @@ func (h *FeatureHandler) GetMyFeatures(c *gin.Context) {
 	h.logger.Debug("Fetching user's created features",
 		logs.WithUserID(userID),
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	featuresList, err := h.featureRepo.GetByCreatedBy(userID)
 	if err != nil {
@@ -565,15 +2342,18 @@ func (h *FeatureHandler) GetMyFeatures(c *gin.Context) {
 			logs.WithUserID(userID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user features"})
 		return
 	}
+	h.applyFreshness(featuresList)
 
 	h.logger.Info("User features retrieved successfully",
 		logs.WithUserID(userID),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK),
 		logs.WithMetadata("feature_count", len(featuresList)))
 
@@ -592,6 +2372,59 @@ func getUserID(c *gin.Context) (int, bool) {
 	return userID.(int), true
 }
 
+// parsePagination reads page and per_page query parameters, falling back to defaults on invalid input
+func parsePagination(c *gin.Context) (int, int) {
+	page := 1
+	perPage := 10
+
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	if perPageStr := c.Query("per_page"); perPageStr != "" {
+		if pp, err := strconv.Atoi(perPageStr); err == nil && pp > 0 && pp <= 100 {
+			perPage = pp
+		}
+	}
+
+	return page, perPage
+}
+
+// setPaginationLinks adds an RFC 5988 Link header with first, prev, next and last page URLs,
+// keeping the request's other query parameters
+func setPaginationLinks(c *gin.Context, page, perPage, total int) {
+	lastPage := (total + perPage - 1) / perPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	link := func(p int, rel string) string {
+		u := *c.Request.URL
+		q := u.Query()
+		q.Set("page", strconv.Itoa(p))
+		q.Set("per_page", strconv.Itoa(perPage))
+		u.RawQuery = q.Encode()
+		return fmt.Sprintf("<%s>; rel=\"%s\"", u.RequestURI(), rel)
+	}
+
+	links := []string{link(1, "first")}
+	if page > 1 {
+		prev := page - 1
+		if prev > lastPage {
+			prev = lastPage
+		}
+		links = append(links, link(prev, "prev"))
+	}
+	if page < lastPage {
+		links = append(links, link(page+1, "next"))
+	}
+	links = append(links, link(lastPage, "last"))
+
+	c.Header("Link", strings.Join(links, ", "))
+}
+
 func getOptionalUserID(c *gin.Context) *int {
 	userID, exists := c.Get("user_id")
 	if !exists {
@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/feature-voting-platform/backend/adapters/logs"
 	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
 	"github.com/feature-voting-platform/backend/domain/features"
 	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/feature-voting-platform/backend/domain/users"
+	usersmocks "github.com/feature-voting-platform/backend/domain/users/mocks"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -45,7 +49,13 @@ func TestFeatureHandler_CreateFeature(t *testing.T) {
 					f.CreatedAt = time.Now()
 					f.UpdatedAt = time.Now()
 				})
-				logger.On("Info", mock.AnythingOfType("string"), mock.Anything)
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{
+					ID:          1,
+					Title:       "New Feature",
+					Description: "Feature Description",
+					CreatedBy:   1,
+				}, nil)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusCreated,
 			expectedBody: map[string]interface{}{
@@ -59,11 +69,13 @@ func TestFeatureHandler_CreateFeature(t *testing.T) {
 				"description": "Feature Description",
 			},
 			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: map[string]interface{}{
-				"error": "Title and description are required",
+				"errors": []interface{}{
+					map[string]interface{}{"field": "title", "message": "is required"},
+				},
 			},
 		},
 		{
@@ -71,11 +83,72 @@ func TestFeatureHandler_CreateFeature(t *testing.T) {
 			userID:      1,
 			requestBody: "invalid json",
 			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: map[string]interface{}{
-				"error": "Invalid request body",
+				"error": "invalid request body",
+			},
+		},
+		{
+			name:   "title over configured limit",
+			userID: 1,
+			requestBody: map[string]string{
+				"title":       strings.Repeat("a", 21),
+				"description": "Feature Description",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "Title must be at most 20 characters",
+			},
+		},
+		{
+			name:   "description over database limit",
+			userID: 1,
+			requestBody: map[string]string{
+				"title":       "New Feature",
+				"description": strings.Repeat("d", features.MaxDescriptionLength+1),
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "Description must be at most 5000 characters",
+			},
+		},
+		{
+			name:   "description repeats title",
+			userID: 1,
+			requestBody: map[string]string{
+				"title":       "Dark mode",
+				"description": "  dark   MODE.  ",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "Description must differ from the title",
+			},
+		},
+		{
+			name:   "expiry in the past",
+			userID: 1,
+			requestBody: map[string]string{
+				"title":       "New Feature",
+				"description": "Feature Description",
+				"expires_at":  time.Now().Add(-time.Hour).Format(time.RFC3339),
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "Expiry must be in the future",
 			},
 		},
 	}
@@ -84,7 +157,7 @@ func TestFeatureHandler_CreateFeature(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := featuresmocks.NewMockRepository(t)
 			logger := logsmocks.NewMockLogger(t)
-			handler := NewFeatureHandler(repo, logger)
+			handler := NewFeatureHandler(repo, logger).WithMaxLengths(20, 0).WithDistinctDescription(true)
 
 			tt.setupMocks(repo, logger)
 
@@ -98,7 +171,7 @@ func TestFeatureHandler_CreateFeature(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, router := gin.CreateTestContext(w)
 
-			c.Set("user_id", tt.userID)
+			router.Use(setUserID(tt.userID))
 			router.POST("/features", handler.CreateFeature)
 
 			req, _ := http.NewRequest(http.MethodPost, "/features", bytes.NewBuffer(requestBody))
@@ -143,15 +216,15 @@ func TestFeatureHandler_GetFeatures(t *testing.T) {
 						Title:           "Feature 1",
 						Description:     "Description 1",
 						CreatedBy:       1,
-						CreatedByUser:   "user1",
+						CreatedByUser:   stringPtr("user1"),
 						VoteCount:       3,
 						CreatedAt:       now,
 						UpdatedAt:       now,
 						HasUserVoted:    true,
 					},
 				}
-				repo.On("GetAll", 1, 10, intPtr(1)).Return(mockFeatures, 1, nil)
-				logger.On("Debug", mock.AnythingOfType("string"), mock.Anything)
+				repo.On("GetAll", 1, 10, intPtr(1), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return(mockFeatures, 1, nil)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
@@ -173,8 +246,8 @@ func TestFeatureHandler_GetFeatures(t *testing.T) {
 			userID:      nil,
 			queryParams: "?page=2&per_page=5",
 			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				repo.On("GetAll", 2, 5, (*int)(nil)).Return([]features.Feature{}, 0, nil)
-				logger.On("Debug", mock.AnythingOfType("string"), mock.Anything)
+				repo.On("GetAll", 2, 5, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 0, nil)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
@@ -182,17 +255,180 @@ func TestFeatureHandler_GetFeatures(t *testing.T) {
 				assert.Equal(t, float64(5), response["per_page"])
 			},
 		},
+		{
+			name:        "page counts round up",
+			userID:      nil,
+			queryParams: "?page=2&per_page=10",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetAll", 2, 10, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 25, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(3), response["total_pages"])
+				assert.Equal(t, true, response["has_next"])
+				assert.Equal(t, true, response["has_prev"])
+			},
+		},
+		{
+			name:        "last page has no next",
+			userID:      nil,
+			queryParams: "?page=2&per_page=10",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetAll", 2, 10, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 20, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(2), response["total_pages"])
+				assert.Equal(t, false, response["has_next"])
+				assert.Equal(t, true, response["has_prev"])
+			},
+		},
+		{
+			name:        "no features means no pages",
+			userID:      nil,
+			queryParams: "",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetAll", 1, 10, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(0), response["total_pages"])
+				assert.Equal(t, false, response["has_next"])
+				assert.Equal(t, false, response["has_prev"])
+			},
+		},
+		{
+			name:        "with tag filter",
+			userID:      nil,
+			queryParams: "?tag=Mobile",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetAll", 1, 10, (*int)(nil), features.SortVotes, "", "mobile", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(0), response["total"])
+			},
+		},
+		{
+			name:        "invalid tag filter",
+			userID:      nil,
+			queryParams: "?tag=not%20a%20tag",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "invalid tag", response["error"])
+			},
+		},
+		{
+			name:        "with creation time range",
+			userID:      nil,
+			queryParams: "?created_after=2026-10-01T00:00:00Z&created_before=2026-10-08T02:00:00%2B02:00",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				after := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+				before := time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC)
+				repo.On("GetAll", 1, 10, (*int)(nil), features.SortVotes, "", "", &after, &before).Return([]features.Feature{}, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(0), response["total"])
+			},
+		},
+		{
+			name:        "invalid created_after",
+			userID:      nil,
+			queryParams: "?created_after=last-week",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "created_after must be an RFC3339 timestamp", response["error"])
+			},
+		},
+		{
+			name:        "created_after later than created_before",
+			userID:      nil,
+			queryParams: "?created_after=2026-10-08T00:00:00Z&created_before=2026-10-01T00:00:00Z",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "created_after must not be later than created_before", response["error"])
+			},
+		},
+		{
+			name:        "with sort parameter",
+			userID:      nil,
+			queryParams: "?sort=newest",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetAll", 1, 10, (*int)(nil), features.SortNewest, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(0), response["total"])
+			},
+		},
+		{
+			name:        "with status filter",
+			userID:      nil,
+			queryParams: "?status=planned",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetAll", 1, 10, (*int)(nil), features.SortVotes, "planned", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{
+					{ID: 3, Title: "Feature 3", Status: "planned"},
+				}, 1, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				featuresData := response["features"].([]interface{})
+				require.Len(t, featuresData, 1)
+				assert.Equal(t, "planned", featuresData[0].(map[string]interface{})["status"])
+			},
+		},
+		{
+			name:        "unknown status filter",
+			userID:      nil,
+			queryParams: "?status=shipped",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "status must be one of open, planned, in_progress, completed, rejected", response["error"])
+			},
+		},
+		{
+			name:        "unknown sort value",
+			userID:      nil,
+			queryParams: "?sort=random",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "sort must be one of votes, newest, oldest", response["error"])
+			},
+		},
 		{
 			name:        "repository error",
 			userID:      nil,
 			queryParams: "",
 			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				repo.On("GetAll", 1, 10, (*int)(nil)).Return(nil, 0, fmt.Errorf("database error"))
-				logger.On("Error", mock.AnythingOfType("string"), mock.Anything, mock.Anything)
+				repo.On("GetAll", 1, 10, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return(nil, 0, fmt.Errorf("database error"))
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
-				assert.Equal(t, "Internal server error", response["error"])
+				assert.Equal(t, "Failed to get features", response["error"])
 			},
 		},
 	}
@@ -209,7 +445,7 @@ func TestFeatureHandler_GetFeatures(t *testing.T) {
 			c, router := gin.CreateTestContext(w)
 
 			if tt.userID != nil {
-				c.Set("user_id", *tt.userID)
+				router.Use(setUserID(*tt.userID))
 			}
 			router.GET("/features", handler.GetFeatures)
 
@@ -252,20 +488,21 @@ func TestFeatureHandler_GetFeature(t *testing.T) {
 					Title:           "Test Feature",
 					Description:     "Test Description",
 					CreatedBy:       1,
-					CreatedByUser:   "testuser",
+					CreatedByUser:   stringPtr("testuser"),
 					VoteCount:       5,
 					CreatedAt:       now,
 					UpdatedAt:       now,
 					HasUserVoted:    true,
 				}
 				repo.On("GetByID", 1, intPtr(1)).Return(feature, nil)
-				logger.On("Debug", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
-				assert.Equal(t, float64(1), response["id"])
-				assert.Equal(t, "Test Feature", response["title"])
-				assert.Equal(t, true, response["has_user_voted"])
+				feature := response["feature"].(map[string]interface{})
+				assert.Equal(t, float64(1), feature["id"])
+				assert.Equal(t, "Test Feature", feature["title"])
+				assert.Equal(t, true, feature["has_user_voted"])
 			},
 		},
 		{
@@ -274,7 +511,7 @@ func TestFeatureHandler_GetFeature(t *testing.T) {
 			featureID: "999",
 			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
 				repo.On("GetByID", 999, (*int)(nil)).Return(nil, fmt.Errorf("feature not found"))
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusNotFound,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
@@ -286,7 +523,7 @@ func TestFeatureHandler_GetFeature(t *testing.T) {
 			userID:    nil,
 			featureID: "invalid",
 			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusBadRequest,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
@@ -307,7 +544,7 @@ func TestFeatureHandler_GetFeature(t *testing.T) {
 			c, router := gin.CreateTestContext(w)
 
 			if tt.userID != nil {
-				c.Set("user_id", *tt.userID)
+				router.Use(setUserID(*tt.userID))
 			}
 			router.GET("/features/:id", handler.GetFeature)
 
@@ -328,75 +565,40 @@ func TestFeatureHandler_GetFeature(t *testing.T) {
 	}
 }
 
-func TestFeatureHandler_UpdateFeature(t *testing.T) {
+func TestFeatureHandler_CreateFeature_DescriptionQuality(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	tests := []struct {
 		name           string
-		userID         int
-		featureID      string
-		requestBody    interface{}
-		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		description    string
+		setupMocks     func(*featuresmocks.MockRepository)
 		expectedStatus int
-		expectedBody   map[string]interface{}
+		expectedError  string
 	}{
 		{
-			name:      "successful update",
-			userID:    1,
-			featureID: "1",
-			requestBody: map[string]string{
-				"title":       "Updated Title",
-				"description": "Updated Description",
-			},
-			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				feature := &features.Feature{
-					ID:        1,
-					CreatedBy: 1,
-				}
-				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
-				repo.On("Update", 1, stringPtr("Updated Title"), stringPtr("Updated Description")).Return(nil)
-				logger.On("Info", mock.AnythingOfType("string"), mock.Anything)
-			},
-			expectedStatus: http.StatusOK,
-			expectedBody: map[string]interface{}{
-				"message": "Feature updated successfully",
-			},
+			name:           "short low-quality description is rejected",
+			description:    "pls add this asap",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedError:  "Description should have at least 5 words explaining what is needed and why",
 		},
 		{
-			name:      "unauthorized - not creator",
-			userID:    2,
-			featureID: "1",
-			requestBody: map[string]string{
-				"title": "Updated Title",
-			},
-			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				feature := &features.Feature{
-					ID:        1,
-					CreatedBy: 1,
-				}
-				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
-			},
-			expectedStatus: http.StatusForbidden,
-			expectedBody: map[string]interface{}{
-				"error": "You can only update features you created",
-			},
+			name:           "description without a complete sentence is rejected",
+			description:    "dark mode for the dashboard and settings pages",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedError:  "Description should contain at least one complete sentence ending in '.', '!' or '?'",
 		},
 		{
-			name:      "feature not found",
-			userID:    1,
-			featureID: "999",
-			requestBody: map[string]string{
-				"title": "Updated Title",
-			},
-			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				repo.On("GetByID", 999, (*int)(nil)).Return(nil, fmt.Errorf("feature not found"))
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
-			},
-			expectedStatus: http.StatusNotFound,
-			expectedBody: map[string]interface{}{
-				"error": "Feature not found",
+			name:        "proper description passes",
+			description: "Add a dark theme to the dashboard. Bright screens are hard to use at night.",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("Create", mock.AnythingOfType("*features.Feature")).Return(nil).Run(func(args mock.Arguments) {
+					args.Get(0).(*features.Feature).ID = 1
+				})
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, Title: "Dark mode", CreatedBy: 1}, nil)
 			},
+			expectedStatus: http.StatusCreated,
 		},
 	}
 
@@ -404,28 +606,20 @@ func TestFeatureHandler_UpdateFeature(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := featuresmocks.NewMockRepository(t)
 			logger := logsmocks.NewMockLogger(t)
-			handler := NewFeatureHandler(repo, logger)
+			handler := NewFeatureHandler(repo, logger).WithDescriptionQuality(true, 5)
 
-			tt.setupMocks(repo, logger)
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
 
-			var requestBody []byte
-			if str, ok := tt.requestBody.(string); ok {
-				requestBody = []byte(str)
-			} else {
-				requestBody, _ = json.Marshal(tt.requestBody)
-			}
+			body, _ := json.Marshal(map[string]string{"title": "Dark mode", "description": tt.description})
 
 			w := httptest.NewRecorder()
-			c, router := gin.CreateTestContext(w)
-
-			c.Set("user_id", tt.userID)
-			router.PUT("/features/:id", handler.UpdateFeature)
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/features", handler.CreateFeature)
 
-			url := "/features/" + tt.featureID
-			req, _ := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(requestBody))
+			req, _ := http.NewRequest(http.MethodPost, "/features", bytes.NewBuffer(body))
 			req.Header.Set("Content-Type", "application/json")
-
-			c.Request = req
 			router.ServeHTTP(w, req)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
@@ -434,79 +628,1600 @@ func TestFeatureHandler_UpdateFeature(t *testing.T) {
 			err := json.Unmarshal(w.Body.Bytes(), &response)
 			require.NoError(t, err)
 
-			for key, expectedValue := range tt.expectedBody {
-				assert.Equal(t, expectedValue, response[key])
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, response["error"])
 			}
 		})
 	}
 }
 
-func TestFeatureHandler_DeleteFeature(t *testing.T) {
+func TestFeatureHandler_CreateFeature_EmailVerification(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	tests := []struct {
 		name           string
-		userID         int
-		featureID      string
-		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		user           *users.User
+		setupMocks     func(*featuresmocks.MockRepository)
 		expectedStatus int
-		expectedBody   map[string]interface{}
 	}{
 		{
-			name:      "successful deletion",
-			userID:    1,
-			featureID: "1",
-			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				feature := &features.Feature{
-					ID:        1,
-					CreatedBy: 1,
-				}
-				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
-				repo.On("Delete", 1).Return(nil)
-				logger.On("Info", mock.AnythingOfType("string"), mock.Anything)
-			},
-			expectedStatus: http.StatusOK,
-			expectedBody: map[string]interface{}{
-				"message": "Feature deleted successfully",
-			},
+			name:           "unverified user is rejected",
+			user:           &users.User{ID: 1, EmailVerified: false},
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusForbidden,
 		},
 		{
-			name:      "unauthorized - not creator",
-			userID:    2,
-			featureID: "1",
-			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
-				feature := &features.Feature{
-					ID:        1,
-					CreatedBy: 1,
-				}
-				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
-				logger.On("Warning", mock.AnythingOfType("string"), mock.Anything)
-			},
-			expectedStatus: http.StatusForbidden,
-			expectedBody: map[string]interface{}{
-				"error": "You can only delete features you created",
+			name: "verified user can create features",
+			user: &users.User{ID: 1, EmailVerified: true},
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("Create", mock.AnythingOfType("*features.Feature")).Return(nil).Run(func(args mock.Arguments) {
+					args.Get(0).(*features.Feature).ID = 1
+				})
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, Title: "Dark mode", CreatedBy: 1}, nil)
 			},
+			expectedStatus: http.StatusCreated,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := featuresmocks.NewMockRepository(t)
+			userRepo := usersmocks.NewMockRepository(t)
 			logger := logsmocks.NewMockLogger(t)
-			handler := NewFeatureHandler(repo, logger)
+			handler := NewFeatureHandler(repo, logger).WithEmailVerificationRequired(userRepo)
 
-			tt.setupMocks(repo, logger)
+			userRepo.On("GetByID", 1).Return(tt.user, nil)
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			body, _ := json.Marshal(map[string]string{"title": "Dark mode", "description": "Add a dark theme to the dashboard."})
 
 			w := httptest.NewRecorder()
-			c, router := gin.CreateTestContext(w)
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/features", handler.CreateFeature)
 
-			c.Set("user_id", tt.userID)
-			router.DELETE("/features/:id", handler.DeleteFeature)
+			req, _ := http.NewRequest(http.MethodPost, "/features", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
 
-			url := "/features/" + tt.featureID
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestFeatureHandler_GetFeatures_Freshness(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Now()
+
+	repo := featuresmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewFeatureHandler(repo, logger).WithNewWindow(48 * time.Hour)
+
+	repo.On("GetAll", 1, 10, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{
+		{ID: 1, Title: "Recent feature", CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: 2, Title: "Older feature", CreatedAt: now.Add(-72 * time.Hour)},
+	}, 2, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.GET("/features", handler.GetFeatures)
+
+	req, _ := http.NewRequest(http.MethodGet, "/features", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	list := response["features"].([]interface{})
+	require.Len(t, list, 2)
+	assert.Equal(t, true, list[0].(map[string]interface{})["is_new"])
+	assert.Equal(t, false, list[1].(map[string]interface{})["is_new"])
+}
+
+func TestFeatureHandler_GetFeatures_LinkHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		query       string
+		page        int
+		wantLinks   []string
+		absentLinks []string
+	}{
+		{
+			name:  "middle page links to both neighbours",
+			query: "?page=2&per_page=10",
+			page:  2,
+			wantLinks: []string{
+				`</features?page=1&per_page=10>; rel="first"`,
+				`</features?page=1&per_page=10>; rel="prev"`,
+				`</features?page=3&per_page=10>; rel="next"`,
+				`</features?page=3&per_page=10>; rel="last"`,
+			},
+		},
+		{
+			name:  "first page has no prev link",
+			query: "?per_page=10",
+			page:  1,
+			wantLinks: []string{
+				`</features?page=2&per_page=10>; rel="next"`,
+				`</features?page=3&per_page=10>; rel="last"`,
+			},
+			absentLinks: []string{`rel="prev"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger).WithLinkHeaders(true)
+
+			repo.On("GetAll", tt.page, 10, (*int)(nil), features.SortVotes, "", "", (*time.Time)(nil), (*time.Time)(nil)).Return([]features.Feature{}, 25, nil)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features", handler.GetFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			link := w.Header().Get("Link")
+			for _, want := range tt.wantLinks {
+				assert.Contains(t, link, want)
+			}
+			for _, absent := range tt.absentLinks {
+				assert.NotContains(t, link, absent)
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_UpdateFeature(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		method         string
+		userID         int
+		role           string
+		featureID      string
+		requestBody    interface{}
+		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:      "successful update",
+			userID:    1,
+			featureID: "1",
+			requestBody: map[string]string{
+				"title":       "Updated Title",
+				"description": "Updated Description",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				feature := &features.Feature{
+					ID:        1,
+					CreatedBy: 1,
+				}
+				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
+				repo.On("Update", 1, stringPtr("Updated Title"), stringPtr("Updated Description"), (*time.Time)(nil)).Return(nil)
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{
+					ID:          1,
+					Title:       "Updated Title",
+					Description: "Updated Description",
+					CreatedBy:   1,
+				}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Feature updated successfully",
+			},
+		},
+		{
+			name:      "unauthorized - not creator",
+			userID:    2,
+			featureID: "1",
+			requestBody: map[string]string{
+				"title":       "Updated Title",
+				"description": "Updated Description",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				feature := &features.Feature{
+					ID:        1,
+					CreatedBy: 1,
+				}
+				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "You can only update your own features",
+			},
+		},
+		{
+			name:      "feature not found",
+			userID:    1,
+			featureID: "999",
+			requestBody: map[string]string{
+				"title":       "Updated Title",
+				"description": "Updated Description",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 999, (*int)(nil)).Return(nil, fmt.Errorf("feature not found"))
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "Feature not found",
+			},
+		},
+		{
+			name:      "put requires the full resource",
+			method:    http.MethodPut,
+			userID:    1,
+			featureID: "1",
+			requestBody: map[string]string{
+				"title": "Updated Title",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "patch updates only the provided fields",
+			method:    http.MethodPatch,
+			userID:    1,
+			featureID: "1",
+			requestBody: map[string]string{
+				"title": "Updated Title",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{
+					ID:          1,
+					Description: "Original Description",
+					CreatedBy:   1,
+				}, nil)
+				repo.On("Update", 1, stringPtr("Updated Title"), (*string)(nil), (*time.Time)(nil)).Return(nil)
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{
+					ID:          1,
+					Title:       "Updated Title",
+					Description: "Original Description",
+					CreatedBy:   1,
+				}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Feature updated successfully",
+			},
+		},
+		{
+			name:        "admin can update another user's feature",
+			method:      http.MethodPatch,
+			userID:      2,
+			role:        "admin",
+			featureID:   "1",
+			requestBody: map[string]string{"description": "Updated Description"},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, Title: "Original Title", CreatedBy: 1}, nil)
+				repo.On("Update", 1, (*string)(nil), stringPtr("Updated Description"), (*time.Time)(nil)).Return(nil)
+				repo.On("GetByID", 1, intPtr(2)).Return(&features.Feature{ID: 1, Description: "Updated Description", CreatedBy: 1}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Feature updated successfully",
+			},
+		},
+		{
+			name:        "patch with another user's feature is still forbidden",
+			method:      http.MethodPatch,
+			userID:      2,
+			featureID:   "1",
+			requestBody: map[string]string{"description": "Updated Description"},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "You can only update your own features",
+			},
+		},
+		{
+			name:      "replace with too short title and no description",
+			userID:    1,
+			featureID: "1",
+			requestBody: map[string]string{
+				"title": "Dark",
+			},
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"errors": []interface{}{
+					map[string]interface{}{"field": "title", "message": "must be at least 5 characters"},
+					map[string]interface{}{"field": "description", "message": "is required"},
+				},
+			},
+		},
+		{
+			name:        "patch with wrong field type",
+			method:      http.MethodPatch,
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"title": 42}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid request body",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo, logger)
+
+			var requestBody []byte
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, _ = json.Marshal(tt.requestBody)
+			}
+
+			w := httptest.NewRecorder()
+			c, router := gin.CreateTestContext(w)
+
+			router.Use(setUserID(tt.userID), setRole(tt.role))
+			router.PUT("/features/:id", handler.UpdateFeature)
+			router.PATCH("/features/:id", handler.PatchFeature)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPut
+			}
+
+			url := "/features/" + tt.featureID
+			req, _ := http.NewRequest(method, url, bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c.Request = req
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_UpdateFeature_MinEditInterval(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		lastUpdated    time.Duration
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		expectRetry    bool
+	}{
+		{
+			name:           "second edit inside the window is blocked",
+			lastUpdated:    10 * time.Second,
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusTooManyRequests,
+			expectRetry:    true,
+		},
+		{
+			name:        "edit outside the window is allowed",
+			lastUpdated: 2 * time.Minute,
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("Update", 1, stringPtr("Updated Title"), (*string)(nil), (*time.Time)(nil)).Return(nil)
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, Title: "Updated Title", CreatedBy: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger).WithMinEditInterval(time.Minute)
+
+			repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{
+				ID:        1,
+				CreatedBy: 1,
+				UpdatedAt: time.Now().Add(-tt.lastUpdated),
+			}, nil)
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.PATCH("/features/:id", handler.PatchFeature)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/features/1", bytes.NewBufferString(`{"title": "Updated Title"}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectRetry {
+				assert.Equal(t, "50", w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_UpdateFeature_MinDescriptionChange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const stored = "Let users switch the whole interface to a dark colour scheme."
+
+	tests := []struct {
+		name           string
+		description    string
+		setupMocks     func(*featuresmocks.MockRepository, string)
+		expectedStatus int
+	}{
+		{
+			name:           "trivial edit is rejected",
+			description:    "Let users switch the whole interface to a dark color scheme.",
+			setupMocks:     func(repo *featuresmocks.MockRepository, description string) {},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "substantial edit passes",
+			description: "Let users switch the interface to a dark colour scheme, following the OS setting by default.",
+			setupMocks: func(repo *featuresmocks.MockRepository, description string) {
+				repo.On("Update", 1, (*string)(nil), &description, (*time.Time)(nil)).Return(nil)
+				repo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, Description: description, CreatedBy: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger).WithMinDescriptionChange(10)
+
+			repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{
+				ID:          1,
+				Title:       "Dark mode",
+				Description: stored,
+				CreatedBy:   1,
+			}, nil)
+			tt.setupMocks(repo, tt.description)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.PATCH("/features/:id", handler.PatchFeature)
+
+			body, _ := json.Marshal(map[string]string{"description": tt.description})
+			req, _ := http.NewRequest(http.MethodPatch, "/features/1", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusUnprocessableEntity {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, "Description edits must change at least 10 characters", response["error"])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_GetAlsoVoted(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		wantFeatures   int
+	}{
+		{
+			name: "features voted by the same users",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("FeatureExists", 1).Return(true, nil)
+				repo.On("GetCoVotedFeatures", 1, 10).Return([]features.CoVotedFeature{
+					{Feature: features.Feature{ID: 2}, CoVoterCount: 3},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantFeatures:   1,
+		},
+		{
+			name: "feature with no voters",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("FeatureExists", 1).Return(true, nil)
+				repo.On("GetCoVotedFeatures", 1, 10).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantFeatures:   0,
+		},
+		{
+			name: "feature not found",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("FeatureExists", 1).Return(false, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/:id/also-voted", handler.GetAlsoVoted)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/1/also-voted", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				list, ok := response["features"].([]interface{})
+				require.True(t, ok, "features should be a JSON array, not null")
+				assert.Len(t, list, tt.wantFeatures)
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_GetRankHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	day := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+	}{
+		{
+			name:  "default window",
+			query: "",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("FeatureExists", 1).Return(true, nil)
+				repo.On("GetRankHistory", 1, 30).Return([]features.RankPoint{{Date: day, VoteCount: 4, Rank: 2}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "window too long",
+			query:          "?days=400",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/:id/rank-history", handler.GetRankHistory)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/1/rank-history"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, float64(30), response["days"])
+				history := response["history"].([]interface{})
+				require.Len(t, history, 1)
+				assert.Equal(t, float64(2), history[0].(map[string]interface{})["rank"])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_GetTopFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+	}{
+		{
+			name:  "week window passes a start time",
+			query: "?window=week&limit=3",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTop", mock.MatchedBy(func(since *time.Time) bool {
+					return since != nil && time.Since(*since) > 6*24*time.Hour
+				}), 3).Return([]features.RankedFeature{{Feature: features.Feature{ID: 1}, WindowVoteCount: 2}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid window",
+			query:          "?window=year",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/top", handler.GetTopFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/top"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestFeatureHandler_GetTrendingFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+	}{
+		{
+			name:  "defaults to a 48 hour window",
+			query: "",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTop", mock.MatchedBy(func(since *time.Time) bool {
+					elapsed := time.Since(*since)
+					return elapsed >= 48*time.Hour && elapsed < 49*time.Hour
+				}), 10).Return([]features.RankedFeature{{Feature: features.Feature{ID: 1, VoteCount: 40}, WindowVoteCount: 12}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "custom window and limit",
+			query: "?window=6h&limit=3",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTop", mock.MatchedBy(func(since *time.Time) bool {
+					elapsed := time.Since(*since)
+					return elapsed >= 6*time.Hour && elapsed < 7*time.Hour
+				}), 3).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unparseable window",
+			query:          "?window=two-days",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "window above the cap",
+			query:          "?window=1000h",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above the cap",
+			query:          "?limit=500",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/trending", handler.GetTrendingFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/trending"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestFeatureHandler_GetTeamPicks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "user with no team gets an empty list",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTeamPicks", 1, 10).Return(nil, fmt.Errorf("user has no team"))
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, false, response["has_team"])
+				assert.Len(t, response["features"], 0)
+			},
+		},
+		{
+			name: "features ranked by teammate votes",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTeamPicks", 1, 10).Return([]features.TeamPick{
+					{Feature: features.Feature{ID: 4, Title: "Dark mode", VoteCount: 9}, TeammateVoteCount: 3},
+					{Feature: features.Feature{ID: 2, Title: "Export to CSV", VoteCount: 20}, TeammateVoteCount: 1},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, true, response["has_team"])
+				picks := response["features"].([]interface{})
+				require.Len(t, picks, 2)
+				first := picks[0].(map[string]interface{})
+				assert.Equal(t, float64(4), first["id"])
+				assert.Equal(t, float64(3), first["teammate_vote_count"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/features/team-picks", handler.GetTeamPicks)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/team-picks", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestFeatureHandler_CompareFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:  "valid comparison keeps requested order",
+			query: "?ids=7,3",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetByIDs", []int{7, 3}, intPtr(1)).Return([]features.Feature{
+					{ID: 3, Title: "Export to CSV", VoteCount: 2},
+					{ID: 7, Title: "Dark mode", VoteCount: 9, HasUserVoted: true},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				list := response["features"].([]interface{})
+				require.Len(t, list, 2)
+				first := list[0].(map[string]interface{})
+				second := list[1].(map[string]interface{})
+				assert.Equal(t, float64(7), first["id"])
+				assert.Equal(t, true, first["has_user_voted"])
+				assert.Equal(t, float64(3), second["id"])
+				assert.Equal(t, float64(2), second["vote_count"])
+			},
+		},
+		{
+			name:  "missing feature",
+			query: "?ids=3,999",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetByIDs", []int{3, 999}, intPtr(1)).Return([]features.Feature{{ID: 3}}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Feature 999 not found", response["error"])
+			},
+		},
+		{
+			name:           "three IDs",
+			query:          "?ids=1,2,3",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "ids must contain exactly two feature IDs", response["error"])
+			},
+		},
+		{
+			name:           "single ID",
+			query:          "?ids=1",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "ids must contain exactly two feature IDs", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/features/compare", handler.CompareFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/compare"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestFeatureHandler_GetFeature_HiddenVoteCounts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		viewerID     int
+		role         string
+		hasVoted     bool
+		expectHidden bool
+	}{
+		{name: "non-voter sees hidden count", viewerID: 2, hasVoted: false, expectHidden: true},
+		{name: "voter sees count", viewerID: 2, hasVoted: true, expectHidden: false},
+		{name: "owner sees count", viewerID: 1, hasVoted: false, expectHidden: false},
+		{name: "admin sees count", viewerID: 2, role: users.RoleAdmin, hasVoted: false, expectHidden: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger).WithHiddenVoteCounts(true)
+
+			repo.On("GetByID", 1, intPtr(tt.viewerID)).Return(&features.Feature{
+				ID:           1,
+				Title:        "Dark mode",
+				CreatedBy:    1,
+				VoteCount:    42,
+				HasUserVoted: tt.hasVoted,
+			}, nil)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(tt.viewerID), setRole(tt.role))
+			router.GET("/features/:id", handler.GetFeature)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/1", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			feature := response["feature"].(map[string]interface{})
+			if tt.expectHidden {
+				assert.Equal(t, true, feature["vote_count_hidden"])
+				assert.Equal(t, float64(0), feature["vote_count"])
+			} else {
+				assert.NotContains(t, feature, "vote_count_hidden")
+				assert.Equal(t, float64(42), feature["vote_count"])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_GetFeature_CategoryCounts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	repo := featuresmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewFeatureHandler(repo, logger).WithCategoryCounts(true)
+
+	repo.On("GetByID", 1, intPtr(2)).Return(&features.Feature{
+		ID:        1,
+		Title:     "Dark mode",
+		CreatedBy: 1,
+		VoteCount: 5,
+	}, nil)
+	repo.On("GetCategoryCounts", 1).Return(map[string]int{"default": 2, "would_pay": 3}, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.Use(setUserID(2))
+	router.GET("/features/:id", handler.GetFeature)
+
+	req, _ := http.NewRequest(http.MethodGet, "/features/1", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	feature := response["feature"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"default": float64(2), "would_pay": float64(3)}, feature["category_counts"])
+}
+
+func TestFeatureHandler_DeleteFeature(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         int
+		role           string
+		featureID      string
+		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:      "successful deletion",
+			userID:    1,
+			featureID: "1",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				feature := &features.Feature{
+					ID:        1,
+					CreatedBy: 1,
+				}
+				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
+				repo.On("Delete", 1).Return(nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Feature deleted successfully",
+			},
+		},
+		{
+			name:      "unauthorized - not creator",
+			userID:    2,
+			featureID: "1",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				feature := &features.Feature{
+					ID:        1,
+					CreatedBy: 1,
+				}
+				repo.On("GetByID", 1, (*int)(nil)).Return(feature, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "You can only delete your own features",
+			},
+		},
+		{
+			name:      "admin can delete another user's feature",
+			userID:    2,
+			role:      "admin",
+			featureID: "1",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				repo.On("Delete", 1).Return(nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Feature deleted successfully",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo, logger)
+
+			w := httptest.NewRecorder()
+			c, router := gin.CreateTestContext(w)
+
+			router.Use(setUserID(tt.userID), setRole(tt.role))
+			router.DELETE("/features/:id", handler.DeleteFeature)
+
+			url := "/features/" + tt.featureID
 			req, _ := http.NewRequest(http.MethodDelete, url, nil)
 
-			c.Request = req
+			c.Request = req
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_AddFeatureTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         int
+		role           string
+		body           string
+		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:   "tags are normalized and deduplicated",
+			userID: 1,
+			body:   `{"tags": [" Mobile ", "ux", "MOBILE"]}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				repo.On("GetTags", 1).Return([]string{"ux"}, nil).Once()
+				repo.On("AddTag", 1, "mobile").Return(nil)
+				repo.On("AddTag", 1, "ux").Return(nil)
+				repo.On("GetTags", 1).Return([]string{"mobile", "ux"}, nil).Once()
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tags": []interface{}{"mobile", "ux"},
+			},
+		},
+		{
+			name:   "not creator",
+			userID: 2,
+			body:   `{"tags": ["mobile"]}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "You can only tag your own features",
+			},
+		},
+		{
+			name:   "admin can tag another user's feature",
+			userID: 2,
+			role:   "admin",
+			body:   `{"tags": ["mobile"]}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				repo.On("GetTags", 1).Return([]string{}, nil).Once()
+				repo.On("AddTag", 1, "mobile").Return(nil)
+				repo.On("GetTags", 1).Return([]string{"mobile"}, nil).Once()
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tags": []interface{}{"mobile"},
+			},
+		},
+		{
+			name:   "invalid tag",
+			userID: 1,
+			body:   `{"tags": ["dark mode"]}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid tag",
+			},
+		},
+		{
+			name:   "tag limit",
+			userID: 1,
+			body:   `{"tags": ["mobile", "ux"]}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				repo.On("GetTags", 1).Return([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "ux"}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "A feature can have at most 10 tags",
+			},
+		},
+		{
+			name:   "feature not found",
+			userID: 1,
+			body:   `{"tags": ["mobile"]}`,
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(nil, fmt.Errorf("feature not found"))
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "Feature not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+
+			router.Use(setUserID(tt.userID), setRole(tt.role))
+			router.POST("/features/:id/tags", handler.AddFeatureTags)
+
+			req, _ := http.NewRequest(http.MethodPost, "/features/1/tags", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_RemoveFeatureTag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         int
+		tag            string
+		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:   "removes the tag",
+			userID: 1,
+			tag:    "Mobile",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				repo.On("RemoveTag", 1, "mobile").Return(nil)
+				repo.On("GetTags", 1).Return([]string{"ux"}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tags": []interface{}{"ux"},
+			},
+		},
+		{
+			name:   "tag the feature does not have",
+			userID: 1,
+			tag:    "desktop",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				repo.On("RemoveTag", 1, "desktop").Return(fmt.Errorf("tag not found"))
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "Tag not found",
+			},
+		},
+		{
+			name:   "not creator",
+			userID: 2,
+			tag:    "mobile",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "You can only tag your own features",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+
+			router.Use(setUserID(tt.userID))
+			router.DELETE("/features/:id/tags/:tag", handler.RemoveFeatureTag)
+
+			req, _ := http.NewRequest(http.MethodDelete, "/features/1/tags/"+tt.tag, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_RestoreFeature(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		featureID      string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:      "admin restores a deleted feature with its votes",
+			role:      "admin",
+			featureID: "1",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("Restore", 1).Return(nil)
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, VoteCount: 7}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				feature := response["feature"].(map[string]interface{})
+				assert.Equal(t, float64(7), feature["vote_count"])
+			},
+		},
+		{
+			name:      "feature is not deleted",
+			role:      "admin",
+			featureID: "2",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("Restore", 2).Return(fmt.Errorf("feature not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Deleted feature not found", response["error"])
+			},
+		},
+		{
+			name:           "non-admin is forbidden",
+			role:           "user",
+			featureID:      "1",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusForbidden,
+			checkResponse:  func(t *testing.T, response map[string]interface{}) {},
+		},
+		{
+			name:           "invalid feature ID",
+			role:           "admin",
+			featureID:      "abc",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Invalid feature ID", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1), setRole(tt.role))
+			router.POST("/features/:id/restore", RequireRole("admin"), handler.RestoreFeature)
+
+			req, _ := http.NewRequest(http.MethodPost, "/features/"+tt.featureID+"/restore", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestFeatureHandler_UpdateFeatureStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         int
+		role           string
+		body           string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:   "creator changes status",
+			userID: 1,
+			body:   `{"status": "planned"}`,
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1, Status: "open"}, nil)
+				repo.On("UpdateStatus", 1, "planned").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": "planned",
+			},
+		},
+		{
+			name:   "admin changes another user's feature",
+			userID: 2,
+			role:   "admin",
+			body:   `{"status": "rejected"}`,
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1, Status: "open"}, nil)
+				repo.On("UpdateStatus", 1, "rejected").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": "rejected",
+			},
+		},
+		{
+			name:   "other user is forbidden",
+			userID: 2,
+			body:   `{"status": "completed"}`,
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, CreatedBy: 1}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "You can only change the status of your own features",
+			},
+		},
+		{
+			name:           "unknown status",
+			userID:         1,
+			body:           `{"status": "shipped"}`,
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "status must be one of open, planned, in_progress, completed, rejected",
+			},
+		},
+		{
+			name:   "feature not found",
+			userID: 1,
+			body:   `{"status": "planned"}`,
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(nil, fmt.Errorf("feature not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "Feature not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(tt.userID), setRole(tt.role))
+			router.PATCH("/features/:id/status", handler.UpdateFeatureStatus)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/features/1/status", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestFeatureHandler_GetVoteDelta(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupMocks     func(*featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:        "vote count increased",
+			queryParams: "?since_count=3",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, VoteCount: 5}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(5), response["vote_count"])
+				assert.Equal(t, float64(3), response["since_count"])
+				assert.Equal(t, float64(2), response["delta"])
+				assert.Equal(t, true, response["changed"])
+			},
+		},
+		{
+			name:        "no change",
+			queryParams: "?since_count=5",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, VoteCount: 5}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(0), response["delta"])
+				assert.Equal(t, false, response["changed"])
+			},
+		},
+		{
+			name:        "missing baseline defaults to zero",
+			queryParams: "",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("GetByID", 1, (*int)(nil)).Return(&features.Feature{ID: 1, VoteCount: 4}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(0), response["since_count"])
+				assert.Equal(t, float64(4), response["delta"])
+			},
+		},
+		{
+			name:        "non-numeric baseline",
+			queryParams: "?since_count=abc",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "since_count must be a non-negative integer", response["error"])
+			},
+		},
+		{
+			name:        "negative baseline",
+			queryParams: "?since_count=-1",
+			setupMocks: func(repo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "since_count must be a non-negative integer", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/:id/vote-delta", handler.GetVoteDelta)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/1/vote-delta"+tt.queryParams, nil)
 			router.ServeHTTP(w, req)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
@@ -515,8 +2230,158 @@ func TestFeatureHandler_DeleteFeature(t *testing.T) {
 			err := json.Unmarshal(w.Body.Bytes(), &response)
 			require.NoError(t, err)
 
-			for key, expectedValue := range tt.expectedBody {
-				assert.Equal(t, expectedValue, response[key])
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestFeatureHandler_HiddenVoteCountsOnRankings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ranked := func() []features.RankedFeature {
+		return []features.RankedFeature{{Feature: features.Feature{ID: 1, CreatedBy: 1, VoteCount: 42}, WindowVoteCount: 7}}
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		route      func(*FeatureHandler) gin.HandlerFunc
+		setupMocks func(*featuresmocks.MockRepository)
+		countKeys  []string
+	}{
+		{
+			name:  "top",
+			path:  "/features/top?window=week",
+			route: func(h *FeatureHandler) gin.HandlerFunc { return h.GetTopFeatures },
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTop", mock.AnythingOfType("*time.Time"), 10).Return(ranked(), nil)
+			},
+			countKeys: []string{"vote_count", "window_vote_count"},
+		},
+		{
+			name:  "trending",
+			path:  "/features/trending",
+			route: func(h *FeatureHandler) gin.HandlerFunc { return h.GetTrendingFeatures },
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetTop", mock.AnythingOfType("*time.Time"), 10).Return(ranked(), nil)
+			},
+			countKeys: []string{"vote_count", "window_vote_count"},
+		},
+		{
+			name:  "surging",
+			path:  "/features/surging",
+			route: func(h *FeatureHandler) gin.HandlerFunc { return h.GetSurgingFeatures },
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetSurgingFeatures", features.DefaultSurgeMultiplier, 10).Return([]features.SurgingFeature{
+					{Feature: features.Feature{ID: 1, CreatedBy: 1, VoteCount: 42}, RecentVoteCount: 9, BaselineDailyVotes: 1.5},
+				}, nil)
+			},
+			countKeys: []string{"vote_count", "recent_vote_count", "baseline_daily_votes"},
+		},
+	}
+
+	viewers := []struct {
+		name         string
+		viewerID     int
+		role         string
+		expectHidden bool
+	}{
+		{name: "anonymous", expectHidden: true},
+		{name: "non-voter", viewerID: 2, expectHidden: true},
+		{name: "creator", viewerID: 1, expectHidden: false},
+		{name: "admin", viewerID: 2, role: users.RoleAdmin, expectHidden: false},
+	}
+
+	for _, tt := range tests {
+		for _, v := range viewers {
+			t.Run(tt.name+"/"+v.name, func(t *testing.T) {
+				repo := featuresmocks.NewMockRepository(t)
+				logger := logsmocks.NewMockLogger(t)
+				handler := NewFeatureHandler(repo, logger).WithHiddenVoteCounts(true)
+
+				tt.setupMocks(repo)
+				expectAnyLogs(logger)
+
+				w := httptest.NewRecorder()
+				_, router := gin.CreateTestContext(w)
+				if v.viewerID != 0 {
+					router.Use(setUserID(v.viewerID), setRole(v.role))
+				}
+				router.GET(strings.Split(tt.path, "?")[0], tt.route(handler))
+
+				req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+				router.ServeHTTP(w, req)
+
+				require.Equal(t, http.StatusOK, w.Code)
+
+				var response map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				list := response["features"].([]interface{})
+				require.Len(t, list, 1)
+				feature := list[0].(map[string]interface{})
+				for _, key := range tt.countKeys {
+					if v.expectHidden {
+						assert.Equal(t, float64(0), feature[key], key)
+					} else {
+						assert.NotEqual(t, float64(0), feature[key], key)
+					}
+				}
+				assert.Equal(t, v.expectHidden, feature["vote_count_hidden"] == true)
+			})
+		}
+	}
+}
+
+func TestFeatureHandler_GetVoteDelta_HiddenVoteCounts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		viewerID     int
+		role         string
+		hasVoted     bool
+		expectHidden bool
+	}{
+		{name: "anonymous", expectHidden: true},
+		{name: "non-voter", viewerID: 2, expectHidden: true},
+		{name: "voter", viewerID: 2, hasVoted: true, expectHidden: false},
+		{name: "admin", viewerID: 2, role: users.RoleAdmin, expectHidden: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger).WithHiddenVoteCounts(true)
+
+			var viewer *int
+			if tt.viewerID != 0 {
+				viewer = intPtr(tt.viewerID)
+			}
+			repo.On("GetByID", 1, viewer).Return(&features.Feature{ID: 1, CreatedBy: 1, VoteCount: 5, HasUserVoted: tt.hasVoted}, nil)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			if tt.viewerID != 0 {
+				router.Use(setUserID(tt.viewerID), setRole(tt.role))
+			}
+			router.GET("/features/:id/vote-delta", handler.GetVoteDelta)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/1/vote-delta?since_count=3", nil)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			if tt.expectHidden {
+				assert.Equal(t, true, response["vote_count_hidden"])
+				assert.NotContains(t, response, "vote_count")
+				assert.NotContains(t, response, "delta")
+			} else {
+				assert.Equal(t, float64(5), response["vote_count"])
+				assert.Equal(t, float64(2), response["delta"])
 			}
 		})
 	}
@@ -529,4 +2394,222 @@ func intPtr(i int) *int {
 
 func stringPtr(s string) *string {
 	return &s
-}
\ No newline at end of file
+}
+// setUserID simulates AuthMiddleware by placing the user ID on the request context
+func setUserID(userID int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func setRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if role != "" {
+			c.Set("role", role)
+		}
+		c.Next()
+	}
+}
+
+// maxLogFields is the largest number of LogFields a handler attaches to a single log call
+const maxLogFields = 16
+
+// expectAnyLogs allows any logger call regardless of how many fields are attached,
+// since the mock matches variadic arguments by exact count
+func expectAnyLogs(logger *logsmocks.MockLogger) {
+	for n := 0; n <= maxLogFields; n++ {
+		fields := make([]interface{}, n)
+		for i := range fields {
+			fields[i] = mock.Anything
+		}
+		withMessage := append([]interface{}{mock.Anything}, fields...)
+		withError := append([]interface{}{mock.Anything, mock.Anything}, fields...)
+		logger.On("Info", withMessage...).Maybe()
+		logger.On("Warning", withMessage...).Maybe()
+		logger.On("Debug", withMessage...).Maybe()
+		logger.On("Error", withError...).Maybe()
+	}
+}
+
+// captureLogs records every log call made through the mock as a LogEntry
+func captureLogs(logger *logsmocks.MockLogger) *[]logs.LogEntry {
+	entries := &[]logs.LogEntry{}
+	record := func(level logs.LogLevel, fieldsFrom int) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			entry := logs.LogEntry{Level: level, Message: args.String(0)}
+			for _, arg := range args[fieldsFrom:] {
+				arg.(logs.LogField)(&entry)
+			}
+			*entries = append(*entries, entry)
+		}
+	}
+
+	for n := 0; n <= maxLogFields; n++ {
+		fields := make([]interface{}, n)
+		for i := range fields {
+			fields[i] = mock.Anything
+		}
+		withMessage := append([]interface{}{mock.Anything}, fields...)
+		withError := append([]interface{}{mock.Anything, mock.Anything}, fields...)
+		logger.On("Info", withMessage...).Maybe().Run(record(logs.LogLevelInfo, 1))
+		logger.On("Warning", withMessage...).Maybe().Run(record(logs.LogLevelWarning, 1))
+		logger.On("Debug", withMessage...).Maybe().Run(record(logs.LogLevelDebug, 1))
+		logger.On("Error", withError...).Maybe().Run(record(logs.LogLevelError, 2))
+	}
+	return entries
+}
+
+func TestFeatureHandler_GetSurgingFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		multiplier     float64
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:       "configured multiplier is passed to the repository",
+			query:      "?limit=3",
+			multiplier: 3,
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetSurgingFeatures", 3.0, 3).Return([]features.SurgingFeature{
+					{Feature: features.Feature{ID: 1, Title: "Dark mode"}, RecentVoteCount: 7, BaselineDailyVotes: 1.25},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(3), response["multiplier"])
+				assert.Equal(t, float64(3), response["limit"])
+				list := response["features"].([]interface{})
+				require.Len(t, list, 1)
+				feature := list[0].(map[string]interface{})
+				assert.Equal(t, float64(1), feature["id"])
+				assert.Equal(t, float64(7), feature["recent_vote_count"])
+				assert.Equal(t, 1.25, feature["baseline_daily_votes"])
+			},
+		},
+		{
+			name: "no surging features returns an empty list",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("GetSurgingFeatures", features.DefaultSurgeMultiplier, 10).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, []interface{}{}, response["features"])
+			},
+		},
+		{
+			name:           "invalid limit",
+			query:          "?limit=0",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse:  func(t *testing.T, response map[string]interface{}) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger).WithSurgeMultiplier(tt.multiplier)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/surging", handler.GetSurgingFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/surging"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestFeatureHandler_SearchFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         *int
+		query          string
+		setupMocks     func(*featuresmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "results carry the viewer's vote status",
+			userID: intPtr(2),
+			query:  "?q=+dark+&per_page=5",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("SearchFeatures", "dark", 1, 5, intPtr(2)).Return([]features.Feature{
+					{ID: 1, Title: "Dark mode", HasUserVoted: true},
+				}, 1, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(1), response["total"])
+				list := response["features"].([]interface{})
+				require.Len(t, list, 1)
+				assert.Equal(t, true, list[0].(map[string]interface{})["has_user_voted"])
+			},
+		},
+		{
+			name:           "empty query",
+			query:          "?q=%20",
+			setupMocks:     func(repo *featuresmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Search query is required", response["error"])
+			},
+		},
+		{
+			name:  "repository error",
+			query: "?q=dark",
+			setupMocks: func(repo *featuresmocks.MockRepository) {
+				repo.On("SearchFeatures", "dark", 1, 10, (*int)(nil)).Return(nil, 0, fmt.Errorf("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Failed to search features", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewFeatureHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			if tt.userID != nil {
+				router.Use(setUserID(*tt.userID))
+			}
+			router.GET("/features/search", handler.SearchFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/search"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			tt.checkResponse(t, response)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels requests that matched no route, so unknown paths share one series
+const unmatchedRoute = "unmatched"
+
+// Metrics holds the Prometheus collectors exported on /metrics. A nil *Metrics is valid
+// and records nothing, so handlers need not check whether metrics are enabled.
+type Metrics struct {
+	requests        *prometheus.CounterVec
+	latency         *prometheus.HistogramVec
+	votesCast       prometheus.Counter
+	featuresCreated prometheus.Counter
+}
+
+// NewMetrics creates the collectors and registers them with reg
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by method, route pattern and status code.",
+		}, []string{"method", "path", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by method and route pattern.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "path"}),
+		votesCast: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "votes_cast_total",
+			Help: "Votes cast on features.",
+		}),
+		featuresCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "features_created_total",
+			Help: "Features created.",
+		}),
+	}
+	reg.MustRegister(m.requests, m.latency, m.votesCast, m.featuresCreated)
+	return m
+}
+
+// VoteCast counts a successfully cast vote
+func (m *Metrics) VoteCast() {
+	if m == nil {
+		return
+	}
+	m.votesCast.Inc()
+}
+
+// FeatureCreated counts a successfully created feature
+func (m *Metrics) FeatureCreated() {
+	if m == nil {
+		return
+	}
+	m.featuresCreated.Inc()
+}
+
+// MetricsMiddleware records a request counter and latency histogram for every request.
+// The path label is the route pattern (/features/:id), never the raw path, to keep the
+// number of series bounded.
+func MetricsMiddleware(m *Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		path := c.FullPath()
+		if path == "" {
+			path = unmatchedRoute
+		}
+		m.requests.WithLabelValues(c.Request.Method, path, strconv.Itoa(c.Writer.Status())).Inc()
+		m.latency.WithLabelValues(c.Request.Method, path).Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsHandler serves the collectors of the default Prometheus registry
+func MetricsHandler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
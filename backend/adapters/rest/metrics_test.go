@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	metrics := NewMetrics(prometheus.NewRegistry())
+
+	router := gin.New()
+	router.Use(MetricsMiddleware(metrics))
+	router.GET("/features/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/features/1", "/features/2", "/features/3", "/nowhere"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		router.ServeHTTP(w, req)
+	}
+
+	// The feature IDs collapse into the route pattern instead of one series each
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.requests))
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, "/features/:id", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, unmatchedRoute, "404")))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.latency))
+}
+
+func TestMetrics_DomainCounters(t *testing.T) {
+	metrics := NewMetrics(prometheus.NewRegistry())
+
+	metrics.VoteCast()
+	metrics.VoteCast()
+	metrics.FeatureCreated()
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.votesCast))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.featuresCreated))
+
+	// Handlers call these unconditionally, so a nil *Metrics must be a no-op
+	var disabled *Metrics
+	assert.NotPanics(t, func() {
+		disabled.VoteCast()
+		disabled.FeatureCreated()
+	})
+}
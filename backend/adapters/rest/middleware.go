@@ -1,22 +1,56 @@
 package rest
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/feature-voting-platform/backend/adapters/auth"
 	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/audit"
+	"github.com/feature-voting-platform/backend/domain/users"
 	"github.com/gin-gonic/gin"
 )
 
-// CORSMiddleware returns a CORS middleware
-func CORSMiddleware() gin.HandlerFunc {
+// CORSMiddleware returns a CORS middleware that only answers cross-origin requests from
+// allowedOrigins, echoing the request's Origin back since browsers reject a wildcard on
+// credentialed requests. An entry of "*" allows every origin, for development.
+func CORSMiddleware(allowedOrigins []string) gin.HandlerFunc {
+	allowAll := false
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			allowAll = true
+		}
+		allowed[normalizeOrigin(origin)] = true
+	}
+
 	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Credentials", "true")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Header("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
+		origin := c.GetHeader("Origin")
+		c.Header("Vary", "Origin")
+
+		if origin != "" && !allowAll && !allowed[normalizeOrigin(origin)] {
+			if c.Request.Method == "OPTIONS" {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if origin != "" {
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Access-Control-Allow-Credentials", "true")
+			c.Header("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, X-Request-ID, Authorization, accept, origin, Cache-Control, X-Requested-With")
+			c.Header("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, PATCH, DELETE")
+		}
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -27,9 +61,245 @@ func CORSMiddleware() gin.HandlerFunc {
 	}
 }
 
-// LoggingMiddleware returns a logging middleware
-func LoggingMiddleware(logger logs.Logger) gin.HandlerFunc {
+// normalizeOrigin makes origins comparable regardless of case and a trailing slash
+func normalizeOrigin(origin string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(origin), "/"))
+}
+
+// SecurityHeadersMiddleware returns a middleware that sets standard security headers.
+// Strict-Transport-Security is only sent over TLS and is skipped when hstsMaxAge is 0;
+// Content-Security-Policy is skipped when csp is empty and under /swagger/, whose UI
+// relies on inline scripts.
+func SecurityHeadersMiddleware(enabled bool, hstsMaxAge int, csp string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !enabled {
+			c.Next()
+			return
+		}
+
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Header("X-Frame-Options", "DENY")
+
+		if hstsMaxAge > 0 && isTLSRequest(c) {
+			c.Header("Strict-Transport-Security", "max-age="+strconv.Itoa(hstsMaxAge)+"; includeSubDomains")
+		}
+
+		if csp != "" && !strings.HasPrefix(c.Request.URL.Path, "/swagger/") {
+			c.Header("Content-Security-Policy", csp)
+		}
+
+		c.Next()
+	}
+}
+
+// CountryResolver maps a client IP to an ISO 3166-1 alpha-2 country code; an empty code
+// means the country is unknown
+type CountryResolver interface {
+	Country(ip string) (string, error)
+}
+
+// NoopCountryResolver resolves every IP to an unknown country
+type NoopCountryResolver struct{}
+
+// Country always reports an unknown country
+func (NoopCountryResolver) Country(string) (string, error) {
+	return "", nil
+}
+
+// GeoBlockMiddleware rejects requests from denied countries, or from countries outside a
+// non-empty allow list, with 451. Requests whose country cannot be resolved are rejected when
+// an allow list is set, since it only admits known countries, and let through otherwise.
+func GeoBlockMiddleware(resolver CountryResolver, allow, deny []string) gin.HandlerFunc {
+	allowed := countrySet(allow)
+	denied := countrySet(deny)
+
 	return func(c *gin.Context) {
+		if len(allowed) == 0 && len(denied) == 0 {
+			c.Next()
+			return
+		}
+
+		country, err := resolver.Country(c.ClientIP())
+		if err != nil || country == "" {
+			if len(allowed) > 0 {
+				abortGeoBlocked(c)
+				return
+			}
+			c.Next()
+			return
+		}
+		country = strings.ToUpper(country)
+
+		if denied[country] || (len(allowed) > 0 && !allowed[country]) {
+			abortGeoBlocked(c)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func abortGeoBlocked(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusUnavailableForLegalReasons, gin.H{"error": "Service not available in your region"})
+}
+
+func countrySet(codes []string) map[string]bool {
+	set := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if code = strings.ToUpper(strings.TrimSpace(code)); code != "" {
+			set[code] = true
+		}
+	}
+	return set
+}
+
+// isTLSRequest reports whether the request arrived over TLS, directly or via a proxy
+func isTLSRequest(c *gin.Context) bool {
+	return c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+}
+
+// AuditMiddleware records requests using one of methods, outside the excludedPrefixes paths,
+// in the audit log. The entry is written before the handler runs and the request is refused
+// with 503 when that fails, so no audited mutation goes unrecorded; the actor and resulting
+// status are filled in once the handler returns.
+func AuditMiddleware(repo audit.Repository, logger logs.Logger, methods []string, excludedPrefixes ...string) gin.HandlerFunc {
+	audited := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		audited[strings.ToUpper(strings.TrimSpace(method))] = true
+	}
+
+	return func(c *gin.Context) {
+		if !audited[c.Request.Method] || isExcludedPath(c.Request.URL.Path, excludedPrefixes) {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(c.Request.Body)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		entry := &audit.Entry{
+			Method:   c.Request.Method,
+			Path:     c.Request.URL.Path,
+			BodyHash: audit.HashBody(body),
+		}
+		if err := repo.Begin(entry); err != nil {
+			logger.Error("Failed to write audit entry, refusing request", err,
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusServiceUnavailable))
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Request could not be audited"})
+			return
+		}
+
+		c.Next()
+
+		var actorID *int
+		if userID, exists := getUserID(c); exists {
+			actorID = &userID
+		}
+		if err := repo.Complete(entry.ID, actorID, c.Writer.Status()); err != nil {
+			logger.Error("Failed to complete audit entry", err,
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(c.Writer.Status()),
+				logs.WithMetadata("audit_id", entry.ID))
+		}
+	}
+}
+
+// RequestIDHeader carries the request ID in both directions
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds client-supplied request IDs so they can't bloat every log line
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDMiddleware gives every request an ID, reusing the client's X-Request-ID when it is
+// usable and generating a UUID otherwise. The ID is stored on the gin and request contexts
+// and echoed back in the response header.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+
+		c.Set("request_id", id)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), requestIDKey{}, id))
+		c.Header(RequestIDHeader, id)
+
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the ID RequestIDMiddleware stored on the request context,
+// or "" outside a request
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestID returns the current request's ID for log entries
+func requestID(c *gin.Context) string {
+	return c.GetString("request_id")
+}
+
+// validRequestID accepts short IDs of printable ASCII without spaces
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID generates a random (version 4) UUID
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:]) // never returns an error since Go 1.24
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// LoggingMiddleware returns a logging middleware. Requests whose path starts with one of
+// excludedPrefixes (health checks, metrics, swagger) are only logged at Debug level,
+// unless they fail.
+func LoggingMiddleware(logger logs.Logger, excludedPrefixes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isExcludedPath(c.Request.URL.Path, excludedPrefixes) {
+			c.Next()
+			if c.Writer.Status() >= 400 {
+				logger.Warning("Request completed with error status",
+					logs.WithMethod(c.Request.Method),
+					logs.WithPath(c.Request.URL.Path),
+					logs.WithRequestID(requestID(c)),
+					logs.WithStatusCode(c.Writer.Status()))
+			} else {
+				logger.Debug("Request completed",
+					logs.WithMethod(c.Request.Method),
+					logs.WithPath(c.Request.URL.Path),
+					logs.WithRequestID(requestID(c)),
+					logs.WithStatusCode(c.Writer.Status()))
+			}
+			return
+		}
+
 		// Start timer
 		startTime := time.Now()
 
@@ -37,6 +307,7 @@ func LoggingMiddleware(logger logs.Logger) gin.HandlerFunc {
 		logger.Info("Request started",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithMetadata("user_agent", c.GetHeader("User-Agent")),
 			logs.WithMetadata("remote_addr", c.ClientIP()))
 
@@ -50,6 +321,7 @@ func LoggingMiddleware(logger logs.Logger) gin.HandlerFunc {
 		logFields := []logs.LogField{
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(c.Writer.Status()),
 			logs.WithMetadata("latency_ms", latency.Milliseconds()),
 			logs.WithMetadata("response_size", c.Writer.Size()),
@@ -68,11 +340,131 @@ func LoggingMiddleware(logger logs.Logger) gin.HandlerFunc {
 	}
 }
 
-// AuthMiddleware returns an authentication middleware
-func AuthMiddleware(tokenService auth.TokenService) gin.HandlerFunc {
+// MaxInFlightMiddleware rejects requests with 503 while limit requests are already being
+// served; a limit of 0 or less disables the cap
+func MaxInFlightMiddleware(limit int) gin.HandlerFunc {
+	if limit <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	slots := make(chan struct{}, limit)
+	return func(c *gin.Context) {
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+			c.Next()
+		default:
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "server busy"})
+		}
+	}
+}
+
+// MaxBodyBytesMiddleware rejects request bodies larger than limit bytes with 413 before any
+// handler parses them; a limit of 0 or less disables the cap. Bodies of unknown length are
+// read up front, through http.MaxBytesReader, so they cannot exceed the limit mid-parse.
+func MaxBodyBytesMiddleware(limit int64) gin.HandlerFunc {
+	if limit <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body too large"})
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		if c.Request.ContentLength < 0 {
+			body, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body too large"})
+					return
+				}
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		c.Next()
+	}
+}
+
+// LoginRateLimitMiddleware rejects login requests with 429 once the client IP has too many
+// failed (401) attempts in the limiter's window; a successful login clears its failures. A nil
+// limiter disables the check
+func LoginRateLimitMiddleware(limiter *auth.LoginLimiter, logger logs.Logger) gin.HandlerFunc {
+	if limiter == nil {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	return func(c *gin.Context) {
+		key := c.ClientIP()
+		if allowed, retryAfter := limiter.Allow(key); !allowed {
+			seconds := int((retryAfter + time.Second - 1) / time.Second)
+			logger.Warning("Login rejected after too many failed attempts",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithMetadata("remote_addr", key),
+				logs.WithStatusCode(http.StatusTooManyRequests),
+				logs.WithMetadata("retry_after_seconds", seconds))
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "Too many failed login attempts, please try again later"})
+			return
+		}
+
+		c.Next()
+
+		switch status := c.Writer.Status(); {
+		case status == http.StatusUnauthorized:
+			limiter.RecordFailure(key)
+		case status >= 200 && status < 300:
+			limiter.Reset(key)
+		}
+	}
+}
+
+func isExcludedPath(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthMiddleware returns an authentication middleware; rejected requests are logged in the security category
+func AuthMiddleware(tokenService auth.TokenService, logger logs.Logger) gin.HandlerFunc {
+	return AuthMiddlewareWithBlacklist(tokenService, nil, logger)
+}
+
+// AuthMiddlewareWithBlacklist is AuthMiddleware that also rejects tokens revoked in the
+// blacklist; a nil blacklist skips the check
+func AuthMiddlewareWithBlacklist(tokenService auth.TokenService, blacklist auth.TokenBlacklist, logger logs.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
+			logger.Warning("Request rejected without authorization header",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusUnauthorized))
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is required"})
 			c.Abort()
 			return
@@ -81,6 +473,12 @@ func AuthMiddleware(tokenService auth.TokenService) gin.HandlerFunc {
 		// Extract token from "Bearer <token>" format
 		parts := strings.SplitN(authHeader, " ", 2)
 		if len(parts) != 2 || parts[0] != "Bearer" {
+			logger.Warning("Request rejected with malformed authorization header",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusUnauthorized))
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization header format"})
 			c.Abort()
 			return
@@ -91,22 +489,85 @@ func AuthMiddleware(tokenService auth.TokenService) gin.HandlerFunc {
 		// Validate token
 		claims, err := tokenService.ValidateToken(token)
 		if err != nil {
+			logger.Warning("Request rejected with invalid token",
+				logs.WithCategory(logs.CategorySecurity),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusUnauthorized),
+				logs.WithMetadata("reason", err.Error()))
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 			c.Abort()
 			return
 		}
 
+		// Tokens issued before IDs were added carry none and cannot have been revoked
+		if blacklist != nil && claims.ID != "" {
+			revoked, err := blacklist.IsRevoked(claims.ID)
+			if err != nil {
+				logger.Error("Failed to check token revocation", err,
+					logs.WithUserID(claims.UserID),
+					logs.WithMethod(c.Request.Method),
+					logs.WithPath(c.Request.URL.Path),
+					logs.WithRequestID(requestID(c)),
+					logs.WithStatusCode(http.StatusInternalServerError))
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify token"})
+				c.Abort()
+				return
+			}
+			if revoked {
+				logger.Warning("Request rejected with revoked token",
+					logs.WithCategory(logs.CategorySecurity),
+					logs.WithUserID(claims.UserID),
+					logs.WithMethod(c.Request.Method),
+					logs.WithPath(c.Request.URL.Path),
+					logs.WithRequestID(requestID(c)),
+					logs.WithStatusCode(http.StatusUnauthorized))
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Token has been revoked"})
+				c.Abort()
+				return
+			}
+		}
+
 		// Set user information in context
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
 		c.Set("email", claims.Email)
+		c.Set("role", claims.Role)
+		c.Set("token_id", claims.ID)
+		if claims.ExpiresAt != nil {
+			c.Set("token_expires_at", claims.ExpiresAt.Time)
+		}
+
+		c.Next()
+	}
+}
 
+// RequireRole returns a middleware that rejects authenticated users without the given role
+// with 403; it must run after AuthMiddleware
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("role") != role {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			return
+		}
 		c.Next()
 	}
 }
 
+// isAdmin reports whether the authenticated user has the admin role
+func isAdmin(c *gin.Context) bool {
+	return c.GetString("role") == users.RoleAdmin
+}
+
 // OptionalAuthMiddleware returns an optional authentication middleware
 func OptionalAuthMiddleware(tokenService auth.TokenService) gin.HandlerFunc {
+	return OptionalAuthMiddlewareWithBlacklist(tokenService, nil)
+}
+
+// OptionalAuthMiddlewareWithBlacklist is OptionalAuthMiddleware that treats tokens revoked in
+// the blacklist, or whose revocation cannot be checked, as absent; a nil blacklist skips the check
+func OptionalAuthMiddlewareWithBlacklist(tokenService auth.TokenService, blacklist auth.TokenBlacklist) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -130,10 +591,18 @@ func OptionalAuthMiddleware(tokenService auth.TokenService) gin.HandlerFunc {
 			return
 		}
 
+		if blacklist != nil && claims.ID != "" {
+			if revoked, err := blacklist.IsRevoked(claims.ID); err != nil || revoked {
+				c.Next()
+				return
+			}
+		}
+
 		// Set user information in context
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
 		c.Set("email", claims.Email)
+		c.Set("role", claims.Role)
 
 		c.Next()
 	}
@@ -0,0 +1,725 @@
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/feature-voting-platform/backend/adapters/auth"
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/audit"
+	auditmocks "github.com/feature-voting-platform/backend/domain/audit/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		allowedOrigins  []string
+		method          string
+		origin          string
+		expectedStatus  int
+		expectedOrigin  string
+		expectedMethods string
+	}{
+		{
+			name:            "allowed origin is echoed back",
+			allowedOrigins:  []string{"https://app.example.com"},
+			method:          http.MethodGet,
+			origin:          "https://app.example.com",
+			expectedStatus:  http.StatusOK,
+			expectedOrigin:  "https://app.example.com",
+			expectedMethods: "POST, GET, OPTIONS, PUT, PATCH, DELETE",
+		},
+		{
+			name:           "disallowed origin gets no CORS headers",
+			allowedOrigins: []string{"https://app.example.com"},
+			method:         http.MethodGet,
+			origin:         "https://evil.example.com",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "preflight from an allowed origin",
+			allowedOrigins:  []string{"https://app.example.com/"},
+			method:          http.MethodOptions,
+			origin:          "https://APP.example.com",
+			expectedStatus:  http.StatusNoContent,
+			expectedOrigin:  "https://APP.example.com",
+			expectedMethods: "POST, GET, OPTIONS, PUT, PATCH, DELETE",
+		},
+		{
+			name:           "preflight from a disallowed origin",
+			allowedOrigins: []string{"https://app.example.com"},
+			method:         http.MethodOptions,
+			origin:         "https://evil.example.com",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:            "wildcard allows any origin without sending a wildcard",
+			allowedOrigins:  []string{"*"},
+			method:          http.MethodGet,
+			origin:          "http://localhost:3000",
+			expectedStatus:  http.StatusOK,
+			expectedOrigin:  "http://localhost:3000",
+			expectedMethods: "POST, GET, OPTIONS, PUT, PATCH, DELETE",
+		},
+		{
+			name:           "same-origin request without an Origin header",
+			allowedOrigins: nil,
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(CORSMiddleware(tt.allowedOrigins))
+			router.GET("/ping", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest(tt.method, "/ping", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.expectedMethods, w.Header().Get("Access-Control-Allow-Methods"))
+			if tt.expectedOrigin != "" {
+				assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+			} else {
+				assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+			}
+			assert.Equal(t, "Origin", w.Header().Get("Vary"))
+		})
+	}
+}
+
+func TestSecurityHeadersMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		forwardedTLS  bool
+		path          string
+		expectHeaders map[string]string
+		absentHeaders []string
+	}{
+		{
+			name:         "enabled over TLS",
+			enabled:      true,
+			forwardedTLS: true,
+			expectHeaders: map[string]string{
+				"X-Content-Type-Options":    "nosniff",
+				"X-Frame-Options":           "DENY",
+				"Strict-Transport-Security": "max-age=3600; includeSubDomains",
+				"Content-Security-Policy":   "default-src 'self'",
+			},
+		},
+		{
+			name:         "enabled over plain HTTP omits HSTS",
+			enabled:      true,
+			forwardedTLS: false,
+			expectHeaders: map[string]string{
+				"X-Content-Type-Options":  "nosniff",
+				"X-Frame-Options":         "DENY",
+				"Content-Security-Policy": "default-src 'self'",
+			},
+			absentHeaders: []string{"Strict-Transport-Security"},
+		},
+		{
+			name:         "swagger UI omits CSP",
+			enabled:      true,
+			forwardedTLS: false,
+			path:         "/swagger/index.html",
+			expectHeaders: map[string]string{
+				"X-Content-Type-Options": "nosniff",
+				"X-Frame-Options":        "DENY",
+			},
+			absentHeaders: []string{"Content-Security-Policy"},
+		},
+		{
+			name:         "disabled",
+			enabled:      false,
+			forwardedTLS: true,
+			absentHeaders: []string{
+				"X-Content-Type-Options",
+				"X-Frame-Options",
+				"Strict-Transport-Security",
+				"Content-Security-Policy",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(SecurityHeadersMiddleware(tt.enabled, 3600, "default-src 'self'"))
+			router.GET("/ping", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			router.GET("/swagger/*any", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			path := tt.path
+			if path == "" {
+				path = "/ping"
+			}
+			req, _ := http.NewRequest(http.MethodGet, path, nil)
+			if tt.forwardedTLS {
+				req.Header.Set("X-Forwarded-Proto", "https")
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			for header, value := range tt.expectHeaders {
+				assert.Equal(t, value, w.Header().Get(header))
+			}
+			for _, header := range tt.absentHeaders {
+				assert.Empty(t, w.Header().Get(header))
+			}
+		})
+	}
+}
+
+func TestLoggingMiddleware_ExcludedPaths(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		path      string
+		wantInfo  bool
+		wantDebug bool
+	}{
+		{name: "excluded path is not logged at info", path: "/health", wantInfo: false, wantDebug: true},
+		{name: "excluded prefix is not logged at info", path: "/swagger/index.html", wantInfo: false, wantDebug: true},
+		{name: "normal path is logged at info", path: "/api/v1/features", wantInfo: true, wantDebug: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logsmocks.NewMockLogger(t)
+			entries := captureLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(LoggingMiddleware(logger, "/health", "/swagger"))
+			router.GET("/*path", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			var gotInfo, gotDebug bool
+			for _, entry := range *entries {
+				gotInfo = gotInfo || entry.Level == logs.LogLevelInfo
+				gotDebug = gotDebug || entry.Level == logs.LogLevelDebug
+			}
+			assert.Equal(t, tt.wantInfo, gotInfo)
+			assert.Equal(t, tt.wantDebug, gotDebug)
+		})
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	tests := []struct {
+		name       string
+		incoming   string
+		wantReused bool
+	}{
+		{name: "incoming ID is reused", incoming: "req-abc-123", wantReused: true},
+		{name: "missing ID is generated", incoming: ""},
+		{name: "ID with spaces is replaced", incoming: "not a valid id"},
+		{name: "overlong ID is replaced", incoming: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logsmocks.NewMockLogger(t)
+			entries := captureLogs(logger)
+
+			var fromContext string
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(RequestIDMiddleware(), LoggingMiddleware(logger))
+			router.GET("/features", func(c *gin.Context) {
+				fromContext = RequestIDFromContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/features", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			router.ServeHTTP(w, req)
+
+			id := w.Header().Get(RequestIDHeader)
+			if tt.wantReused {
+				assert.Equal(t, tt.incoming, id)
+			} else {
+				assert.Regexp(t, uuidPattern, id)
+			}
+			assert.Equal(t, id, fromContext)
+
+			// Both the start and completion entries carry the same ID
+			assert.Len(t, *entries, 2)
+			for _, entry := range *entries {
+				assert.Equal(t, id, entry.RequestID)
+			}
+		})
+	}
+}
+
+func TestMaxBodyBytesMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const limit = 64
+
+	tests := []struct {
+		name           string
+		body           string
+		chunked        bool
+		expectedStatus int
+	}{
+		{
+			name:           "body within the limit is parsed",
+			body:           `{"title": "Dark mode"}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "oversized body is rejected",
+			body:           `{"title": "` + strings.Repeat("a", limit) + `"}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "oversized body of unknown length is rejected",
+			body:           `{"title": "` + strings.Repeat("a", limit) + `"}`,
+			chunked:        true,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "small body of unknown length is parsed",
+			body:           `{"title": "Dark mode"}`,
+			chunked:        true,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed := false
+			router := gin.New()
+			router.Use(MaxBodyBytesMiddleware(limit))
+			router.POST("/features", func(c *gin.Context) {
+				var req struct {
+					Title string `json:"title"`
+				}
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				parsed = true
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/features", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedStatus == http.StatusOK, parsed)
+		})
+	}
+}
+
+func TestMaxInFlightMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const limit = 2
+	const total = 6
+
+	entered := make(chan struct{}, total)
+	release := make(chan struct{})
+
+	router := gin.New()
+	router.Use(MaxInFlightMiddleware(limit))
+	router.GET("/slow", func(c *gin.Context) {
+		entered <- struct{}{}
+		<-release
+		c.Status(http.StatusOK)
+	})
+
+	results := make(chan int, total)
+	for i := 0; i < total; i++ {
+		go func() {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/slow", nil)
+			router.ServeHTTP(w, req)
+			results <- w.Code
+		}()
+	}
+
+	// Once the limit is occupied, every other request must be turned away without blocking
+	for i := 0; i < limit; i++ {
+		<-entered
+	}
+	for i := 0; i < total-limit; i++ {
+		assert.Equal(t, http.StatusServiceUnavailable, <-results)
+	}
+
+	close(release)
+	for i := 0; i < limit; i++ {
+		assert.Equal(t, http.StatusOK, <-results)
+	}
+	assert.Empty(t, entered)
+
+	// Slots are released on completion
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/slow", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLoginRateLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const window = 100 * time.Millisecond
+
+	logger := logsmocks.NewMockLogger(t)
+	expectAnyLogs(logger)
+
+	router := gin.New()
+	router.POST("/login", LoginRateLimitMiddleware(auth.NewLoginLimiter(2, window), logger), func(c *gin.Context) {
+		if c.GetHeader("X-Password") == "right" {
+			c.Status(http.StatusOK)
+			return
+		}
+		c.Status(http.StatusUnauthorized)
+	})
+
+	login := func(ip, password string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/login", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set("X-Password", password)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, login("10.0.0.1", "wrong").Code)
+	assert.Equal(t, http.StatusUnauthorized, login("10.0.0.1", "wrong").Code)
+
+	// Further attempts are refused, even with the right password, until the window passes
+	w := login("10.0.0.1", "right")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	assert.Equal(t, http.StatusOK, login("10.0.0.2", "right").Code)
+
+	time.Sleep(window + 20*time.Millisecond)
+	assert.Equal(t, http.StatusOK, login("10.0.0.1", "right").Code)
+
+	// A successful login forgets earlier failures
+	assert.Equal(t, http.StatusUnauthorized, login("10.0.0.1", "wrong").Code)
+	assert.Equal(t, http.StatusOK, login("10.0.0.1", "right").Code)
+	assert.Equal(t, http.StatusUnauthorized, login("10.0.0.1", "wrong").Code)
+	assert.Equal(t, http.StatusUnauthorized, login("10.0.0.1", "wrong").Code)
+	assert.Equal(t, http.StatusTooManyRequests, login("10.0.0.1", "wrong").Code)
+}
+
+type stubCountryResolver map[string]string
+
+func (s stubCountryResolver) Country(ip string) (string, error) {
+	return s[ip], nil
+}
+
+type failingCountryResolver struct{}
+
+func (failingCountryResolver) Country(string) (string, error) {
+	return "", fmt.Errorf("lookup failed")
+}
+
+func TestGeoBlockMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	resolver := stubCountryResolver{
+		"10.0.0.1": "br",
+		"10.0.0.2": "KP",
+		"10.0.0.3": "FR",
+	}
+
+	tests := []struct {
+		name       string
+		resolver   CountryResolver
+		allow      []string
+		deny       []string
+		remoteIP   string
+		wantStatus int
+	}{
+		{
+			name:       "allowed country passes",
+			allow:      []string{"BR", "US"},
+			remoteIP:   "10.0.0.1",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "denied country is blocked",
+			deny:       []string{"kp"},
+			remoteIP:   "10.0.0.2",
+			wantStatus: http.StatusUnavailableForLegalReasons,
+		},
+		{
+			name:       "country outside the allow list is blocked",
+			allow:      []string{"BR"},
+			remoteIP:   "10.0.0.3",
+			wantStatus: http.StatusUnavailableForLegalReasons,
+		},
+		{
+			name:       "unresolved country is blocked by an allow list",
+			allow:      []string{"BR"},
+			remoteIP:   "10.0.0.9",
+			wantStatus: http.StatusUnavailableForLegalReasons,
+		},
+		{
+			name:       "unresolved country passes a deny list",
+			deny:       []string{"KP"},
+			remoteIP:   "10.0.0.9",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "resolver failure is blocked by an allow list",
+			resolver:   failingCountryResolver{},
+			allow:      []string{"BR"},
+			remoteIP:   "10.0.0.1",
+			wantStatus: http.StatusUnavailableForLegalReasons,
+		},
+		{
+			name:       "resolver failure passes a deny list",
+			resolver:   failingCountryResolver{},
+			deny:       []string{"KP"},
+			remoteIP:   "10.0.0.2",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r CountryResolver = resolver
+			if tt.resolver != nil {
+				r = tt.resolver
+			}
+			router := gin.New()
+			router.Use(GeoBlockMiddleware(r, tt.allow, tt.deny))
+			router.GET("/test", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+			req.RemoteAddr = tt.remoteIP + ":12345"
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body := `{"title":"Dark mode","password":"hunter2"}`
+	created := http.StatusCreated
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		setupMocks func(*auditmocks.MockRepository, *logsmocks.MockLogger)
+		wantStatus int
+		wantBody   bool
+	}{
+		{
+			name:   "POST is recorded with actor and status",
+			method: http.MethodPost,
+			path:   "/features",
+			setupMocks: func(repo *auditmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("Begin", mock.MatchedBy(func(e *audit.Entry) bool {
+					return e.Method == http.MethodPost && e.Path == "/features" && e.BodyHash == audit.HashBody([]byte(body))
+				})).Run(func(args mock.Arguments) {
+					args.Get(0).(*audit.Entry).ID = 9
+				}).Return(nil)
+				repo.On("Complete", 9, intPtr(42), created).Return(nil)
+			},
+			wantStatus: created,
+			wantBody:   true,
+		},
+		{
+			name:       "GET is not audited",
+			method:     http.MethodGet,
+			path:       "/features",
+			setupMocks: func(*auditmocks.MockRepository, *logsmocks.MockLogger) {},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "excluded path is not audited",
+			method:     http.MethodPost,
+			path:       "/health/ping",
+			setupMocks: func(*auditmocks.MockRepository, *logsmocks.MockLogger) {},
+			wantStatus: created,
+			wantBody:   true,
+		},
+		{
+			name:   "request is refused when the entry can't be written",
+			method: http.MethodPost,
+			path:   "/features",
+			setupMocks: func(repo *auditmocks.MockRepository, logger *logsmocks.MockLogger) {
+				repo.On("Begin", mock.Anything).Return(fmt.Errorf("connection refused"))
+				logger.On("Error", "Failed to write audit entry, refusing request", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := auditmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			tt.setupMocks(repo, logger)
+
+			handler := func(c *gin.Context) {
+				c.Set("user_id", 42)
+				received, _ := io.ReadAll(c.Request.Body)
+				if c.Request.Method == http.MethodGet {
+					c.Status(http.StatusOK)
+					return
+				}
+				c.String(created, string(received))
+			}
+
+			router := gin.New()
+			router.Use(AuditMiddleware(repo, logger, []string{"post", "PUT", "PATCH", "DELETE"}, "/health"))
+			router.Any("/*path", handler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, strings.NewReader(body))
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody {
+				assert.Equal(t, body, w.Body.String(), "handler should still see the full body")
+			}
+		})
+	}
+}
+
+func TestAuditHashBody_RedactsSensitiveFields(t *testing.T) {
+	a := audit.HashBody([]byte(`{"email":"a@example.com","password":"first","nested":{"Token":"x"}}`))
+	b := audit.HashBody([]byte(`{"nested":{"Token":"y"},"password":"second","email":"a@example.com"}`))
+	c := audit.HashBody([]byte(`{"email":"b@example.com","password":"first","nested":{"Token":"x"}}`))
+
+	assert.Equal(t, a, b, "only redacted fields and key order differ")
+	assert.NotEqual(t, a, c)
+	assert.Len(t, a, 64)
+	assert.Empty(t, audit.HashBody(nil))
+}
+
+func TestRequireRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		role       string
+		wantStatus int
+	}{
+		{name: "admin passes", role: "admin", wantStatus: http.StatusOK},
+		{name: "regular user is forbidden", role: "user", wantStatus: http.StatusForbidden},
+		{name: "token without a role is forbidden", role: "", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(setRole(tt.role), RequireRole("admin"))
+			router.GET("/admin/test", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/admin/test", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestOptionalAuthMiddlewareWithBlacklist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenService := auth.NewJWTService("test-secret")
+	blacklist := auth.NewMemoryTokenBlacklist()
+
+	token, err := tokenService.GenerateToken(1, "testuser", "test@example.com", "admin")
+	require.NoError(t, err)
+	revokedToken, err := tokenService.GenerateToken(1, "testuser", "test@example.com", "admin")
+	require.NoError(t, err)
+	claims, err := tokenService.ValidateToken(revokedToken)
+	require.NoError(t, err)
+	require.NoError(t, blacklist.Revoke(claims.ID, claims.ExpiresAt.Time))
+
+	router := gin.New()
+	router.GET("/", OptionalAuthMiddlewareWithBlacklist(tokenService, blacklist), func(c *gin.Context) {
+		c.String(http.StatusOK, "%d %s", c.GetInt("user_id"), c.GetString("role"))
+	})
+
+	tests := []struct {
+		name     string
+		bearer   string
+		wantBody string
+	}{
+		{name: "valid token personalizes", bearer: token, wantBody: "1 admin"},
+		{name: "revoked token is anonymous", bearer: revokedToken, wantBody: "0 "},
+		{name: "no token is anonymous", wantBody: "0 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if tt.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.bearer)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/notifications"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler handles notification-related HTTP requests
+type NotificationHandler struct {
+	prefsRepo notifications.PreferencesRepository
+	logger    logs.Logger
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(prefsRepo notifications.PreferencesRepository, logger logs.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		prefsRepo: prefsRepo,
+		logger:    logger,
+	}
+}
+
+// loadPreferences returns the user's stored preferences, or the defaults when none are stored
+func (h *NotificationHandler) loadPreferences(userID int) (*notifications.Preferences, error) {
+	prefs, err := h.prefsRepo.GetPreferences(userID)
+	if err != nil {
+		if err.Error() == "preferences not found" {
+			return notifications.DefaultPreferences(userID), nil
+		}
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// GetPreferences godoc
+// @Summary Get notification preferences
+// @Description Get the authenticated user's notification preferences; defaults apply when none are stored
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} notifications.Preferences "Notification preferences"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /notifications/preferences [get]
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get notification preferences attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	prefs, err := h.loadPreferences(userID)
+	if err != nil {
+		h.logger.Error("Failed to get notification preferences", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get notification preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"preferences": prefs,
+	})
+}
+
+// UpdatePreferences godoc
+// @Summary Update notification preferences
+// @Description Update some or all of the authenticated user's notification preferences
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param preferences body notifications.UpdatePreferencesRequest true "Preferences to change"
+// @Success 200 {object} notifications.Preferences "Updated notification preferences"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /notifications/preferences [put]
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Update notification preferences attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req notifications.UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Update notification preferences request validation failed", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+
+	prefs, err := h.loadPreferences(userID)
+	if err != nil {
+		h.logger.Error("Failed to get notification preferences for update", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get notification preferences"})
+		return
+	}
+
+	req.Apply(prefs)
+
+	if err := h.prefsRepo.UpsertPreferences(prefs); err != nil {
+		h.logger.Error("Failed to save notification preferences", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save notification preferences"})
+		return
+	}
+
+	h.logger.Info("Notification preferences updated",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "Notification preferences updated",
+		"preferences": prefs,
+	})
+}
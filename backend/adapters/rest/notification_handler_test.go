@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/notifications"
+	notificationsmocks "github.com/feature-voting-platform/backend/domain/notifications/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationHandler_Preferences(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		setupMocks     func(*notificationsmocks.MockPreferencesRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "read defaults when none stored",
+			method: http.MethodGet,
+			setupMocks: func(repo *notificationsmocks.MockPreferencesRepository) {
+				repo.On("GetPreferences", 1).Return(nil, fmt.Errorf("preferences not found"))
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				prefs := response["preferences"].(map[string]interface{})
+				assert.Equal(t, true, prefs["vote_on_my_feature"])
+				assert.Equal(t, true, prefs["status_change"])
+				assert.Equal(t, true, prefs["subscription_updates"])
+			},
+		},
+		{
+			name:   "update keeps unspecified toggles",
+			method: http.MethodPut,
+			body:   `{"status_change": false}`,
+			setupMocks: func(repo *notificationsmocks.MockPreferencesRepository) {
+				repo.On("GetPreferences", 1).Return(&notifications.Preferences{
+					UserID:              1,
+					VoteOnMyFeature:     false,
+					StatusChange:        true,
+					SubscriptionUpdates: true,
+				}, nil)
+				repo.On("UpsertPreferences", mock.MatchedBy(func(p *notifications.Preferences) bool {
+					return p.UserID == 1 && !p.VoteOnMyFeature && !p.StatusChange && p.SubscriptionUpdates
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				prefs := response["preferences"].(map[string]interface{})
+				assert.Equal(t, false, prefs["vote_on_my_feature"])
+				assert.Equal(t, false, prefs["status_change"])
+				assert.Equal(t, true, prefs["subscription_updates"])
+			},
+		},
+		{
+			name:   "first update starts from defaults",
+			method: http.MethodPut,
+			body:   `{"vote_on_my_feature": false}`,
+			setupMocks: func(repo *notificationsmocks.MockPreferencesRepository) {
+				repo.On("GetPreferences", 1).Return(nil, fmt.Errorf("preferences not found"))
+				repo.On("UpsertPreferences", mock.MatchedBy(func(p *notifications.Preferences) bool {
+					return !p.VoteOnMyFeature && p.StatusChange && p.SubscriptionUpdates
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Notification preferences updated", response["message"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := notificationsmocks.NewMockPreferencesRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewNotificationHandler(repo, logger)
+
+			tt.setupMocks(repo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/notifications/preferences", handler.GetPreferences)
+			router.PUT("/notifications/preferences", handler.UpdatePreferences)
+
+			req, _ := http.NewRequest(tt.method, "/notifications/preferences", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
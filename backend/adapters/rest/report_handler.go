@@ -0,0 +1,215 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/feature-voting-platform/backend/domain/reports"
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler handles feature moderation report HTTP requests
+type ReportHandler struct {
+	featureRepo features.Repository
+	reportRepo  reports.Repository
+	logger      logs.Logger
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(featureRepo features.Repository, reportRepo reports.Repository, logger logs.Logger) *ReportHandler {
+	return &ReportHandler{
+		featureRepo: featureRepo,
+		reportRepo:  reportRepo,
+		logger:      logger,
+	}
+}
+
+// ReportFeature godoc
+// @Summary Report a feature
+// @Description Flag a feature as inappropriate for moderators; each user can report a feature once
+// @Tags features
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Param request body reports.CreateReportRequest true "Report reason: spam, abusive, duplicate, inappropriate or other"
+// @Success 201 {object} map[string]interface{} "Feature reported successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 409 {object} map[string]interface{} "Feature already reported"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/report [post]
+func (h *ReportHandler) ReportFeature(c *gin.Context) {
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for report",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Report attempt without authentication",
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req reports.CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warning("Report request validation failed",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
+	if !reports.ValidReason(req.Reason) {
+		h.logger.Warning("Invalid report reason",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("reason", req.Reason))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "reason must be one of " + strings.Join(reports.Reasons, ", ")})
+		return
+	}
+
+	exists, err = h.featureRepo.FeatureExists(featureID)
+	if err != nil {
+		h.logger.Error("Failed to check feature existence for report", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+		return
+	}
+	if !exists {
+		h.logger.Info("Report attempt on non-existent feature",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusNotFound))
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	report, err := h.reportRepo.Create(featureID, userID, req.Reason)
+	if err != nil {
+		if err.Error() == "feature already reported" {
+			h.logger.Info("Duplicate feature report",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusConflict))
+			c.JSON(http.StatusConflict, gin.H{"error": "You have already reported this feature"})
+			return
+		}
+		h.logger.Error("Failed to create report in database", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to report feature"})
+		return
+	}
+
+	h.logger.Info("Feature reported",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusCreated),
+		logs.WithCategory(logs.CategorySecurity),
+		logs.WithMetadata("report_id", report.ID),
+		logs.WithMetadata("reason", report.Reason))
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Feature reported successfully",
+		"report":  report,
+	})
+}
+
+// GetReports godoc
+// @Summary List feature reports
+// @Description List reports of inappropriate features, newest first, optionally for one feature (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param feature_id query int false "Only reports of this feature"
+// @Success 200 {object} map[string]interface{} "Reports"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /admin/reports [get]
+func (h *ReportHandler) GetReports(c *gin.Context) {
+	var (
+		list []reports.Report
+		err  error
+	)
+
+	if featureIDStr := c.Query("feature_id"); featureIDStr != "" {
+		featureID, convErr := strconv.Atoi(featureIDStr)
+		if convErr != nil {
+			h.logger.Warning("Invalid feature ID for reports",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("provided_id", featureIDStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+			return
+		}
+		list, err = h.reportRepo.GetByFeature(featureID)
+	} else {
+		list, err = h.reportRepo.GetAll()
+	}
+	if err != nil {
+		h.logger.Error("Failed to get reports from database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get reports"})
+		return
+	}
+
+	h.logger.Info("Reports retrieved successfully",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("report_count", len(list)))
+
+	c.JSON(http.StatusOK, gin.H{"reports": list})
+}
@@ -0,0 +1,184 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/feature-voting-platform/backend/domain/reports"
+	reportsmocks "github.com/feature-voting-platform/backend/domain/reports/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportHandler_ReportFeature(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		featureID      string
+		requestBody    string
+		setupMocks     func(*featuresmocks.MockRepository, *reportsmocks.MockRepository)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:        "feature reported",
+			featureID:   "1",
+			requestBody: `{"reason": "spam"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, reportRepo *reportsmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				reportRepo.On("Create", 1, 1, "spam").Return(&reports.Report{ID: 10, FeatureID: 1, ReporterID: 1, Reason: "spam"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"message": "Feature reported successfully",
+			},
+		},
+		{
+			name:        "unknown reason",
+			featureID:   "1",
+			requestBody: `{"reason": "boring"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, reportRepo *reportsmocks.MockRepository) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "reason must be one of spam, abusive, duplicate, inappropriate, other",
+			},
+		},
+		{
+			name:        "feature not found",
+			featureID:   "99",
+			requestBody: `{"reason": "spam"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, reportRepo *reportsmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 99).Return(false, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "Feature not found",
+			},
+		},
+		{
+			name:        "already reported",
+			featureID:   "1",
+			requestBody: `{"reason": "abusive"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, reportRepo *reportsmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				reportRepo.On("Create", 1, 1, "abusive").Return(nil, fmt.Errorf("feature already reported"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"error": "You have already reported this feature",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			reportRepo := reportsmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewReportHandler(featureRepo, reportRepo, logger)
+
+			tt.setupMocks(featureRepo, reportRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/features/:id/report", handler.ReportFeature)
+
+			req, _ := http.NewRequest(http.MethodPost, "/features/"+tt.featureID+"/report", strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestReportHandler_GetReports(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*reportsmocks.MockRepository)
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:  "all reports",
+			query: "",
+			setupMocks: func(reportRepo *reportsmocks.MockRepository) {
+				reportRepo.On("GetAll").Return([]reports.Report{
+					{ID: 2, FeatureID: 3, ReporterID: 4, Reason: "abusive", CreatedAt: now},
+					{ID: 1, FeatureID: 1, ReporterID: 2, Reason: "spam", CreatedAt: now},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  2,
+		},
+		{
+			name:  "reports of one feature",
+			query: "?feature_id=1",
+			setupMocks: func(reportRepo *reportsmocks.MockRepository) {
+				reportRepo.On("GetByFeature", 1).Return([]reports.Report{
+					{ID: 1, FeatureID: 1, ReporterID: 2, Reason: "spam", CreatedAt: now},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "invalid feature ID",
+			query:          "?feature_id=abc",
+			setupMocks:     func(reportRepo *reportsmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportRepo := reportsmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewReportHandler(featuresmocks.NewMockRepository(t), reportRepo, logger)
+
+			tt.setupMocks(reportRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/admin/reports", handler.GetReports)
+
+			req, _ := http.NewRequest(http.MethodGet, "/admin/reports"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response struct {
+					Reports []reports.Report `json:"reports"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Len(t, response.Reports, tt.expectedCount)
+			}
+		})
+	}
+}
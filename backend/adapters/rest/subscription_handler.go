@@ -0,0 +1,263 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/feature-voting-platform/backend/domain/subscriptions"
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionHandler handles feature subscription HTTP requests
+type SubscriptionHandler struct {
+	featureRepo      features.Repository
+	subscriptionRepo subscriptions.Repository
+	logger           logs.Logger
+}
+
+// NewSubscriptionHandler creates a new subscription handler
+func NewSubscriptionHandler(featureRepo features.Repository, subscriptionRepo subscriptions.Repository, logger logs.Logger) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		featureRepo:      featureRepo,
+		subscriptionRepo: subscriptionRepo,
+		logger:           logger,
+	}
+}
+
+// Subscribe godoc
+// @Summary Subscribe to a feature
+// @Description Subscribe the authenticated user to updates on a feature
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Success 200 {object} map[string]interface{} "Subscribed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/subscribe [post]
+func (h *SubscriptionHandler) Subscribe(c *gin.Context) {
+	h.logger.Info("Subscribe to feature request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for subscription",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Subscribe attempt without authentication",
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	exists, err = h.featureRepo.FeatureExists(featureID)
+	if err != nil {
+		h.logger.Error("Failed to check feature existence for subscription", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+		return
+	}
+	if !exists {
+		h.logger.Info("Subscribe attempt on non-existent feature",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusNotFound))
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	if err := h.subscriptionRepo.Subscribe(userID, featureID); err != nil {
+		h.logger.Error("Failed to subscribe to feature", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to subscribe to feature"})
+		return
+	}
+
+	h.logger.Info("Subscribed to feature successfully",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Subscribed successfully",
+		"feature_id": featureID,
+	})
+}
+
+// Unsubscribe godoc
+// @Summary Unsubscribe from a feature
+// @Description Remove the authenticated user's subscription to a feature
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Success 200 {object} map[string]interface{} "Unsubscribed successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 404 {object} map[string]interface{} "Subscription not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/subscribe [delete]
+func (h *SubscriptionHandler) Unsubscribe(c *gin.Context) {
+	h.logger.Info("Unsubscribe from feature request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for unsubscribe",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Unsubscribe attempt without authentication",
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	if err := h.subscriptionRepo.Unsubscribe(userID, featureID); err != nil {
+		if err.Error() == "subscription not found" {
+			h.logger.Info("Unsubscribe attempt without subscription",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Subscription not found"})
+			return
+		}
+		h.logger.Error("Failed to unsubscribe from feature", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unsubscribe from feature"})
+		return
+	}
+
+	h.logger.Info("Unsubscribed from feature successfully",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Unsubscribed successfully",
+		"feature_id": featureID,
+	})
+}
+
+// GetMySubscriptions godoc
+// @Summary Get current user's subscribed features
+// @Description Get a paginated list of the features the authenticated user is subscribed to, newest subscription first
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} features.FeatureListResponse "Subscribed features"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /subscriptions/my [get]
+func (h *SubscriptionHandler) GetMySubscriptions(c *gin.Context) {
+	h.logger.Info("Get my subscriptions request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get my subscriptions attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, perPage := parsePagination(c)
+
+	featuresList, total, err := h.subscriptionRepo.GetSubscribedFeatures(userID, page, perPage)
+	if err != nil {
+		h.logger.Error("Failed to get subscribed features from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get subscriptions"})
+		return
+	}
+	if featuresList == nil {
+		featuresList = []features.Feature{}
+	}
+
+	h.logger.Info("Subscribed features retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("total_features", total),
+		logs.WithMetadata("returned_count", len(featuresList)))
+
+	c.JSON(http.StatusOK, features.NewFeatureListResponse(featuresList, total, page, perPage))
+}
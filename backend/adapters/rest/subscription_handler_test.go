@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/features"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	subscriptionsmocks "github.com/feature-voting-platform/backend/domain/subscriptions/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionHandler_GetMySubscriptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*subscriptionsmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:  "custom page and per_page",
+			query: "?page=2&per_page=5",
+			setupMocks: func(subRepo *subscriptionsmocks.MockRepository, logger *logsmocks.MockLogger) {
+				subRepo.On("GetSubscribedFeatures", 1, 2, 5).Return([]features.Feature{
+					{ID: 11, Title: "Dark mode", VoteCount: 4},
+				}, 6, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(2), response["page"])
+				assert.Equal(t, float64(5), response["per_page"])
+				assert.Equal(t, float64(6), response["total"])
+				list := response["features"].([]interface{})
+				require.Len(t, list, 1)
+				feature := list[0].(map[string]interface{})
+				assert.Equal(t, "Dark mode", feature["title"])
+				assert.Equal(t, float64(4), feature["vote_count"])
+			},
+		},
+		{
+			name:  "invalid pagination falls back to defaults",
+			query: "?page=0&per_page=500",
+			setupMocks: func(subRepo *subscriptionsmocks.MockRepository, logger *logsmocks.MockLogger) {
+				subRepo.On("GetSubscribedFeatures", 1, 1, 10).Return(nil, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, float64(1), response["page"])
+				assert.Equal(t, float64(10), response["per_page"])
+				assert.Len(t, response["features"], 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			subRepo := subscriptionsmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewSubscriptionHandler(featureRepo, subRepo, logger)
+
+			tt.setupMocks(subRepo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/subscriptions/my", handler.GetMySubscriptions)
+
+			req, _ := http.NewRequest(http.MethodGet, "/subscriptions/my"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
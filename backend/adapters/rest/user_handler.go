@@ -0,0 +1,270 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/features"
+	"github.com/feature-voting-platform/backend/domain/users"
+	"github.com/gin-gonic/gin"
+)
+
+// UserHandler handles public user-related HTTP requests
+type UserHandler struct {
+	userRepo    users.Repository
+	featureRepo features.Repository
+	logger      logs.Logger
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(userRepo users.Repository, featureRepo features.Repository, logger logs.Logger) *UserHandler {
+	return &UserHandler{
+		userRepo:    userRepo,
+		featureRepo: featureRepo,
+		logger:      logger,
+	}
+}
+
+// GetPublicProfile godoc
+// @Summary Get a user's public profile
+// @Description Get the public profile of a user with feature and vote stats
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} users.PublicProfileResponse "Public profile"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "User not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /users/{id} [get]
+func (h *UserHandler) GetPublicProfile(c *gin.Context) {
+	h.logger.Info("Get public profile request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid user ID provided",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	user, err := h.userRepo.GetByID(id)
+	if err != nil {
+		if err.Error() == "user not found" {
+			h.logger.Info("User not found",
+				logs.WithUserID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		h.logger.Error("Failed to get user from database", err,
+			logs.WithUserID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
+		return
+	}
+
+	featureCount, err := h.featureRepo.CountByCreator(id)
+	if err != nil {
+		h.logger.Error("Failed to count user features", err,
+			logs.WithUserID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user stats"})
+		return
+	}
+
+	votesReceived, err := h.featureRepo.CountVotesReceived(id)
+	if err != nil {
+		h.logger.Error("Failed to count votes received", err,
+			logs.WithUserID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user stats"})
+		return
+	}
+
+	h.logger.Info("Public profile retrieved successfully",
+		logs.WithUserID(user.ID),
+		logs.WithUsername(user.Username),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("feature_count", featureCount),
+		logs.WithMetadata("votes_received", votesReceived))
+
+	c.JSON(http.StatusOK, gin.H{
+		"user": user.ToPublicProfile(featureCount, votesReceived),
+	})
+}
+
+// GetUserStats godoc
+// @Summary Get a user's feature statistics
+// @Description Get aggregate statistics over the features a user has created
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} features.UserStats "User statistics"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "User not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /users/{id}/stats [get]
+func (h *UserHandler) GetUserStats(c *gin.Context) {
+	h.logger.Info("Get user stats request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid user ID provided",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if _, err := h.userRepo.GetByID(id); err != nil {
+		if err.Error() == "user not found" {
+			h.logger.Info("User not found",
+				logs.WithUserID(id),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		h.logger.Error("Failed to get user from database", err,
+			logs.WithUserID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
+		return
+	}
+
+	stats, err := h.featureRepo.GetUserStats(id)
+	if err != nil {
+		h.logger.Error("Failed to get user stats from database", err,
+			logs.WithUserID(id),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user stats"})
+		return
+	}
+
+	h.logger.Info("User stats retrieved successfully",
+		logs.WithUserID(id),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("feature_count", stats.FeatureCount),
+		logs.WithMetadata("votes_received", stats.TotalVotesReceived))
+
+	c.JSON(http.StatusOK, gin.H{"stats": stats})
+}
+
+// GetWhatsNew godoc
+// @Summary Get changes since the previous login
+// @Description Count features created by others and votes on the authenticated user's features since their previous login. On a first login there is no previous login and all counts are zero.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} features.ChangeSummary "Changes since the previous login"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/me/whats-new [get]
+func (h *UserHandler) GetWhatsNew(c *gin.Context) {
+	h.logger.Info("Get what's new request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get what's new attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	previous, err := h.userRepo.GetPreviousLoginAt(userID)
+	if err != nil {
+		h.logger.Error("Failed to get previous login from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get changes"})
+		return
+	}
+
+	if previous == nil {
+		h.logger.Info("First login, nothing to summarize",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusOK))
+		c.JSON(http.StatusOK, gin.H{"changes": features.ChangeSummary{FirstLogin: true}})
+		return
+	}
+
+	summary, err := h.featureRepo.GetChangesSince(userID, *previous)
+	if err != nil {
+		h.logger.Error("Failed to get changes from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get changes"})
+		return
+	}
+
+	h.logger.Info("Changes since previous login retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("new_features", summary.NewFeatures),
+		logs.WithMetadata("new_votes_on_my_features", summary.NewVotesOnMyFeatures))
+
+	c.JSON(http.StatusOK, gin.H{"changes": summary})
+}
@@ -0,0 +1,237 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/features"
+	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
+	"github.com/feature-voting-platform/backend/domain/users"
+	usersmocks "github.com/feature-voting-platform/backend/domain/users/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_GetPublicProfile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	joined := time.Date(2025, 8, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		userID         string
+		setupMocks     func(*usersmocks.MockRepository, *featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "profile with stats and no private fields",
+			userID: "1",
+			setupMocks: func(userRepo *usersmocks.MockRepository, featureRepo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				userRepo.On("GetByID", 1).Return(&users.User{
+					ID:           1,
+					Username:     "testuser",
+					Email:        "test@example.com",
+					PasswordHash: "hashed_password",
+					CreatedAt:    joined,
+				}, nil)
+				featureRepo.On("CountByCreator", 1).Return(3, nil)
+				featureRepo.On("CountVotesReceived", 1).Return(12, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				user := response["user"].(map[string]interface{})
+				assert.Equal(t, float64(1), user["id"])
+				assert.Equal(t, "testuser", user["username"])
+				assert.Equal(t, joined.Format(time.RFC3339), user["joined_at"])
+				assert.Equal(t, float64(3), user["feature_count"])
+				assert.Equal(t, float64(12), user["votes_received"])
+				assert.NotContains(t, user, "email")
+				assert.NotContains(t, user, "password_hash")
+			},
+		},
+		{
+			name:   "unknown user",
+			userID: "999",
+			setupMocks: func(userRepo *usersmocks.MockRepository, featureRepo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				userRepo.On("GetByID", 999).Return(nil, fmt.Errorf("user not found"))
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "User not found", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := usersmocks.NewMockRepository(t)
+			featureRepo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewUserHandler(userRepo, featureRepo, logger)
+
+			tt.setupMocks(userRepo, featureRepo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/users/:id", handler.GetPublicProfile)
+
+			req, _ := http.NewRequest(http.MethodGet, "/users/"+tt.userID, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUserHandler_GetUserStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         string
+		setupMocks     func(*usersmocks.MockRepository, *featuresmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "user without features gets zeroed stats",
+			userID: "2",
+			setupMocks: func(userRepo *usersmocks.MockRepository, featureRepo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				userRepo.On("GetByID", 2).Return(&users.User{ID: 2, Username: "newcomer"}, nil)
+				featureRepo.On("GetUserStats", 2).Return(features.UserStats{UserID: 2}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				stats := response["stats"].(map[string]interface{})
+				assert.Equal(t, float64(2), stats["user_id"])
+				assert.Equal(t, float64(0), stats["feature_count"])
+				assert.Equal(t, float64(0), stats["total_votes_received"])
+				assert.Equal(t, float64(0), stats["average_votes_per_feature"])
+				assert.Nil(t, stats["most_voted_feature_title"])
+			},
+		},
+		{
+			name:   "unknown user",
+			userID: "999",
+			setupMocks: func(userRepo *usersmocks.MockRepository, featureRepo *featuresmocks.MockRepository, logger *logsmocks.MockLogger) {
+				userRepo.On("GetByID", 999).Return(nil, fmt.Errorf("user not found"))
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "User not found", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := usersmocks.NewMockRepository(t)
+			featureRepo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewUserHandler(userRepo, featureRepo, logger)
+
+			tt.setupMocks(userRepo, featureRepo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/users/:id/stats", handler.GetUserStats)
+
+			req, _ := http.NewRequest(http.MethodGet, "/users/"+tt.userID+"/stats", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUserHandler_GetWhatsNew(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := time.Date(2026, 10, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*usersmocks.MockRepository, *featuresmocks.MockRepository)
+		checkResponse func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "changes since the previous login",
+			setupMocks: func(userRepo *usersmocks.MockRepository, featureRepo *featuresmocks.MockRepository) {
+				userRepo.On("GetPreviousLoginAt", 1).Return(&previous, nil)
+				featureRepo.On("GetChangesSince", 1, previous).Return(features.ChangeSummary{
+					Since:                &previous,
+					NewFeatures:          4,
+					NewVotesOnMyFeatures: 7,
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				changes := response["changes"].(map[string]interface{})
+				assert.Equal(t, "2026-10-10T09:00:00Z", changes["since"])
+				assert.Equal(t, false, changes["first_login"])
+				assert.Equal(t, float64(4), changes["new_features"])
+				assert.Equal(t, float64(7), changes["new_votes_on_my_features"])
+			},
+		},
+		{
+			name: "first login has nothing to summarize",
+			setupMocks: func(userRepo *usersmocks.MockRepository, featureRepo *featuresmocks.MockRepository) {
+				userRepo.On("GetPreviousLoginAt", 1).Return((*time.Time)(nil), nil)
+			},
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				changes := response["changes"].(map[string]interface{})
+				assert.Nil(t, changes["since"])
+				assert.Equal(t, true, changes["first_login"])
+				assert.Equal(t, float64(0), changes["new_features"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := usersmocks.NewMockRepository(t)
+			featureRepo := featuresmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewUserHandler(userRepo, featureRepo, logger)
+
+			tt.setupMocks(userRepo, featureRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/auth/me/whats-new", handler.GetWhatsNew)
+
+			req, _ := http.NewRequest(http.MethodGet, "/auth/me/whats-new", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
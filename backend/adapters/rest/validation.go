@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError describes one request field that failed validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// bindErrorResponse turns a ShouldBindJSON error for req into a response body: validation
+// failures become {"errors": [{"field", "message"}]} keyed by JSON field name, anything else
+// (malformed JSON, wrong types) a generic "invalid request body" error
+func bindErrorResponse(err error, req interface{}) gin.H {
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return gin.H{"error": "invalid request body"}
+	}
+
+	fieldErrors := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   jsonFieldName(req, fe.StructField()),
+			Message: validationMessage(fe),
+		})
+	}
+	return gin.H{"errors": fieldErrors}
+}
+
+// jsonFieldName returns the JSON name of a top-level field of req, falling back to the
+// lower-cased Go name when the field has no json tag
+func jsonFieldName(req interface{}, structField string) string {
+	t := reflect.TypeOf(req)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t != nil && t.Kind() == reflect.Struct {
+		if f, ok := t.FieldByName(structField); ok {
+			if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+				return name
+			}
+		}
+	}
+	return strings.ToLower(structField)
+}
+
+// validationMessage phrases a failed validation rule for API clients
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return boundMessage(fe, "at least")
+	case "max":
+		return boundMessage(fe, "at most")
+	case "email":
+		return "must be a valid email address"
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(fe.Param()), ", ")
+	default:
+		return "is invalid"
+	}
+}
+
+// boundMessage phrases a min or max rule according to the kind of value it limits
+func boundMessage(fe validator.FieldError, bound string) string {
+	switch fe.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("must be %s %s characters", bound, fe.Param())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return fmt.Sprintf("must contain %s %s item(s)", bound, fe.Param())
+	default:
+		return fmt.Sprintf("must be %s %s", bound, fe.Param())
+	}
+}
@@ -0,0 +1,34 @@
+package rest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindErrorResponse(t *testing.T) {
+	var req struct {
+		Name  string `json:"name" binding:"max=3"`
+		IDs   []int  `json:"feature_ids" binding:"min=1"`
+		Role  string `json:"role" binding:"oneof=user admin"`
+		Other int    `binding:"required"`
+	}
+	req.Name = "toolong"
+	req.Role = "owner"
+
+	err := binding.Validator.ValidateStruct(&req)
+	require.Error(t, err)
+
+	assert.Equal(t, gin.H{"errors": []FieldError{
+		{Field: "name", Message: "must be at most 3 characters"},
+		{Field: "feature_ids", Message: "must contain at least 1 item(s)"},
+		{Field: "role", Message: "must be one of user, admin"},
+		{Field: "other", Message: "is required"},
+	}}, bindErrorResponse(err, &req))
+
+	assert.Equal(t, gin.H{"error": "invalid request body"}, bindErrorResponse(errors.New("unexpected EOF"), &req))
+}
@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/feature-voting-platform/backend/internal/version"
+	"github.com/gin-gonic/gin"
+)
+
+// GetVersion godoc
+// @Summary Get server build information
+// @Description Get the build version, git commit, build time and Go runtime version
+// @Tags system
+// @Produce json
+// @Success 200 {object} version.Info "Build information"
+// @Router /version [get]
+func GetVersion(c *gin.Context) {
+	c.JSON(http.StatusOK, version.Get())
+}
@@ -1,10 +1,17 @@
 package rest
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/feature-voting-platform/backend/adapters/logs"
+	"github.com/feature-voting-platform/backend/domain/activity"
 	"github.com/feature-voting-platform/backend/domain/features"
 	"github.com/feature-voting-platform/backend/domain/votes"
 	"github.com/gin-gonic/gin"
@@ -15,6 +22,21 @@ type VoteHandler struct {
 	featureRepo features.Repository
 	voteRepo    votes.Repository
 	logger      logs.Logger
+	voteQuota   int
+	undoWindow  time.Duration
+	warnMargin  int
+	activity    activity.Repository
+	categories  map[string]bool
+	metrics     *Metrics
+}
+
+// WarningApproachingVoteQuota is returned once a user has few votes left under the quota
+const WarningApproachingVoteQuota = "APPROACHING_VOTE_QUOTA"
+
+// responseWarning is a non-fatal notice attached to a successful response
+type responseWarning struct {
+	Code      string `json:"code"`
+	Remaining int    `json:"remaining"`
 }
 
 // NewVoteHandler creates a new vote handler
@@ -26,25 +48,135 @@ func NewVoteHandler(featureRepo features.Repository, voteRepo votes.Repository,
 	}
 }
 
+// WithVoteQuota limits how many features a user may vote for at once; 0 means unlimited
+func (h *VoteHandler) WithVoteQuota(quota int) *VoteHandler {
+	h.voteQuota = quota
+	return h
+}
+
+// WithQuotaWarningMargin warns in vote responses once the user has margin or fewer votes
+// left under the quota; 0 disables the warning
+func (h *VoteHandler) WithQuotaWarningMargin(margin int) *VoteHandler {
+	h.warnMargin = margin
+	return h
+}
+
+// quotaWarnings returns a warning when the user is within the configured margin of their
+// vote quota. Failing to count votes only drops the warning; the vote itself succeeded.
+func (h *VoteHandler) quotaWarnings(c *gin.Context, userID int) []responseWarning {
+	if h.voteQuota <= 0 || h.warnMargin <= 0 {
+		return nil
+	}
+
+	count, err := h.voteRepo.CountByUser(userID)
+	if err != nil {
+		h.logger.Error("Failed to count user votes for quota warning", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)))
+		return nil
+	}
+
+	remaining := h.voteQuota - count
+	if remaining < 0 {
+		remaining = 0
+	}
+	if remaining > h.warnMargin {
+		return nil
+	}
+
+	return []responseWarning{{Code: WarningApproachingVoteQuota, Remaining: remaining}}
+}
+
+// WithActivity records vote milestones in the activity stream
+func (h *VoteHandler) WithActivity(repo activity.Repository) *VoteHandler {
+	h.activity = repo
+	return h
+}
+
+// WithMetrics counts cast votes in the Prometheus metrics
+func (h *VoteHandler) WithMetrics(m *Metrics) *VoteHandler {
+	h.metrics = m
+	return h
+}
+
+// recordMilestone announces the feature's new vote count when it is a milestone
+func (h *VoteHandler) recordMilestone(c *gin.Context, userID int, feature *features.Feature) {
+	if !activity.IsVoteMilestone(feature.VoteCount) {
+		return
+	}
+
+	milestone := feature.VoteCount
+	recordActivity(c, h.activity, h.logger, activity.Event{
+		Type:      activity.TypeVoteMilestone,
+		ActorID:   &userID,
+		FeatureID: feature.ID,
+		Milestone: &milestone,
+	})
+}
+
+// WithUndoWindow sets how long after casting a vote it can still be undone; 0 disables undo
+func (h *VoteHandler) WithUndoWindow(window time.Duration) *VoteHandler {
+	h.undoWindow = window
+	return h
+}
+
+// WithVoteCategories allows votes in the named categories in addition to the default one;
+// a user may vote once per category per feature
+func (h *VoteHandler) WithVoteCategories(categories []string) *VoteHandler {
+	h.categories = make(map[string]bool, len(categories))
+	for _, category := range categories {
+		h.categories[category] = true
+	}
+	return h
+}
+
+// voteCategory resolves the requested category, treating an empty name as the default one
+func (h *VoteHandler) voteCategory(name string) (string, bool) {
+	if name == "" || name == votes.DefaultCategory {
+		return votes.DefaultCategory, true
+	}
+	return name, h.categories[name]
+}
+
+// quotaReached reports whether the user has used up their vote quota
+func (h *VoteHandler) quotaReached(userID int) (bool, error) {
+	if h.voteQuota <= 0 {
+		return false, nil
+	}
+
+	count, err := h.voteRepo.CountByUser(userID)
+	if err != nil {
+		return false, err
+	}
+
+	return count >= h.voteQuota, nil
+}
+
 // VoteForFeature godoc
 // @Summary Vote for a feature
-// @Description Add a vote for a specific feature
+// @Description Add an upvote or downvote for a specific feature, optionally with a short reason and a vote category; a user may vote once per category, and voting again in the other direction switches the vote. The feature's vote_count is its net score
 // @Tags votes
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Feature ID"
-// @Success 200 {object} map[string]interface{} "Vote added successfully"
+// @Param request body votes.CastVoteRequest false "Optional vote reason, category and direction (up or down, default up)"
+// @Success 200 {object} map[string]interface{} "Vote added, or already recorded by a concurrent or retried request, with the stored value"
 // @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Vote quota reached or voting closed"
 // @Failure 404 {object} map[string]interface{} "Feature not found"
-// @Failure 409 {object} map[string]interface{} "Already voted"
+// @Failure 409 {object} map[string]interface{} "Already voted in this direction"
+// @Failure 422 {object} map[string]interface{} "Reason too long"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /features/{id}/vote [post]
 func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 	h.logger.Info("Vote for feature request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	idStr := c.Param("id")
 	featureID, err := strconv.Atoi(idStr)
@@ -52,6 +184,7 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 		h.logger.Warning("Invalid feature ID for voting",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest),
 			logs.WithMetadata("provided_id", idStr))
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
@@ -64,16 +197,79 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
+	// The body is optional; only bind it when the client sent one
+	var req votes.CastVoteRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Error("Vote request validation failed", err,
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest))
+			c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+			return
+		}
+	}
+	if req.Reason != nil {
+		trimmed := strings.TrimSpace(*req.Reason)
+		if trimmed == "" {
+			req.Reason = nil
+		} else {
+			req.Reason = &trimmed
+		}
+	}
+	if req.Reason != nil && utf8.RuneCountInString(*req.Reason) > votes.MaxReasonLength {
+		h.logger.Warning("Vote reason exceeds length limit",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnprocessableEntity))
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": fmt.Sprintf("Reason must be at most %d characters", votes.MaxReasonLength)})
+		return
+	}
+	category, ok := h.voteCategory(req.Category)
+	if !ok {
+		h.logger.Warning("Vote in unknown category",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("category", req.Category))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown vote category"})
+		return
+	}
+	value, ok := votes.ParseDirection(req.Direction)
+	if !ok {
+		h.logger.Warning("Vote with unknown direction",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("direction", req.Direction))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "direction must be up or down"})
+		return
+	}
+
 	h.logger.Info("Processing vote request",
 		logs.WithUserID(userID),
 		logs.WithFeatureID(featureID),
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	// Check if feature exists
 	exists, err = h.featureRepo.FeatureExists(featureID)
@@ -83,6 +279,7 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
 		return
@@ -93,47 +290,116 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusNotFound))
 		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
 		return
 	}
 
-	// Check if user has already voted
-	hasVoted, err := h.voteRepo.HasUserVoted(userID, featureID)
-	if err != nil {
+	// Check if user has already voted in this category, in either direction
+	existing, err := h.voteRepo.GetUserVote(userID, featureID, category)
+	if err != nil && err.Error() != "vote not found" {
 		h.logger.Error("Failed to check user vote status", err,
 			logs.WithUserID(userID),
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check vote status"})
 		return
 	}
 
-	if hasVoted {
+	if existing != nil && existing.Value == value {
 		h.logger.Info("Duplicate vote attempt",
 			logs.WithUserID(userID),
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusConflict))
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusConflict),
+			logs.WithMetadata("category", category),
+			logs.WithMetadata("existing_value", existing.Value))
 		c.JSON(http.StatusConflict, gin.H{"error": "You have already voted for this feature"})
 		return
 	}
+	if existing != nil {
+		// Switching direction keeps the vote, so it does not count against the quota
+		h.changeVoteDirection(c, userID, featureID, category, value)
+		return
+	}
+
+	reached, err := h.quotaReached(userID)
+	if err != nil {
+		h.logger.Error("Failed to check user vote quota", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check vote quota"})
+		return
+	}
+	if reached {
+		h.logger.Info("Vote attempt beyond quota",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusForbidden),
+			logs.WithMetadata("vote_quota", h.voteQuota))
+		c.JSON(http.StatusForbidden, gin.H{"error": "Vote quota reached"})
+		return
+	}
 
 	// Add vote
-	if err := h.voteRepo.AddVote(userID, featureID); err != nil {
+	inserted, err := h.voteRepo.AddVote(userID, featureID, category, value, req.Reason)
+	if err != nil {
+		if errors.Is(err, votes.ErrVotingClosed) {
+			h.logger.Info("Vote rejected on feature closed for voting",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusForbidden))
+			c.JSON(http.StatusForbidden, gin.H{"error": "Voting on this feature has closed"})
+			return
+		}
 		h.logger.Error("Failed to add vote to database", err,
 			logs.WithUserID(userID),
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add vote"})
 		return
 	}
 
+	message := "Vote added successfully"
+	if inserted {
+		h.metrics.VoteCast()
+	} else {
+		// A concurrent or retried request stored this vote first; report the stored vote
+		stored, err := h.voteRepo.GetUserVote(userID, featureID, category)
+		if err != nil {
+			h.logger.Error("Failed to get stored vote after duplicate vote", err,
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check vote status"})
+			return
+		}
+		value = stored.Value
+		message = "Vote already recorded"
+	}
+
 	// Get updated feature to return current vote count
 	updatedFeature, err := h.featureRepo.GetByID(featureID, &userID)
 	if err != nil {
@@ -142,22 +408,113 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated feature"})
 		return
 	}
 
-	h.logger.Info("Vote added successfully",
+	if inserted {
+		h.recordMilestone(c, userID, updatedFeature)
+	}
+
+	h.logger.Info(message,
 		logs.WithUserID(userID),
 		logs.WithFeatureID(featureID),
 		logs.WithVoteCount(updatedFeature.VoteCount),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK))
 
+	response := gin.H{
+		"message":    message,
+		"feature_id": featureID,
+		"category":   category,
+		"value":      value,
+		"vote_count": updatedFeature.VoteCount,
+		"has_voted":  true,
+	}
+	if warnings := h.quotaWarnings(c, userID); warnings != nil {
+		response["warnings"] = warnings
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// changeVoteDirection turns the user's existing vote in category to value and responds with the
+// feature's new vote count
+func (h *VoteHandler) changeVoteDirection(c *gin.Context, userID, featureID int, category string, value int) {
+	changed, err := h.voteRepo.ChangeVoteDirection(userID, featureID, category, value)
+	if err != nil {
+		if errors.Is(err, votes.ErrVotingClosed) {
+			h.logger.Info("Vote direction change rejected on feature closed for voting",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusForbidden))
+			c.JSON(http.StatusForbidden, gin.H{"error": "Voting on this feature has closed"})
+			return
+		}
+		h.logger.Error("Failed to change vote direction", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change vote direction"})
+		return
+	}
+	if !changed {
+		// A concurrent request changed or removed the vote first
+		h.logger.Info("Vote direction already changed",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusConflict),
+			logs.WithMetadata("category", category))
+		c.JSON(http.StatusConflict, gin.H{"error": "You have already voted for this feature"})
+		return
+	}
+
+	updatedFeature, err := h.featureRepo.GetByID(featureID, &userID)
+	if err != nil {
+		h.logger.Error("Failed to get updated feature after changing vote direction", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated feature"})
+		return
+	}
+
+	if value == votes.Upvote {
+		h.recordMilestone(c, userID, updatedFeature)
+	}
+
+	h.logger.Info("Vote direction changed",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(featureID),
+		logs.WithVoteCount(updatedFeature.VoteCount),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("category", category),
+		logs.WithMetadata("value", value))
+
 	c.JSON(http.StatusOK, gin.H{
-		"message":    "Vote added successfully",
+		"message":    "Vote direction changed",
 		"feature_id": featureID,
+		"category":   category,
+		"value":      value,
 		"vote_count": updatedFeature.VoteCount,
 		"has_voted":  true,
 	})
@@ -171,6 +528,7 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Feature ID"
+// @Param category query string false "Vote category" default(default)
 // @Success 200 {object} map[string]interface{} "Vote removed successfully"
 // @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 401 {object} map[string]interface{} "Unauthorized"
@@ -180,7 +538,8 @@ func (h *VoteHandler) VoteForFeature(c *gin.Context) {
 func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 	h.logger.Info("Remove vote from feature request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	idStr := c.Param("id")
 	featureID, err := strconv.Atoi(idStr)
@@ -188,6 +547,7 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 		h.logger.Warning("Invalid feature ID for vote removal",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusBadRequest),
 			logs.WithMetadata("provided_id", idStr))
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
@@ -200,16 +560,32 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
+	category, ok := h.voteCategory(c.Query("category"))
+	if !ok {
+		h.logger.Warning("Vote removal in unknown category",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("category", c.Query("category")))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown vote category"})
+		return
+	}
+
 	h.logger.Info("Processing vote removal request",
 		logs.WithUserID(userID),
 		logs.WithFeatureID(featureID),
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	// Check if feature exists
 	exists, err = h.featureRepo.FeatureExists(featureID)
@@ -219,6 +595,7 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
 		return
@@ -229,19 +606,21 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusNotFound))
 		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
 		return
 	}
 
 	// Remove vote
-	if err := h.voteRepo.RemoveVote(userID, featureID); err != nil {
+	if err := h.voteRepo.RemoveVote(userID, featureID, category); err != nil {
 		if err.Error() == "vote not found" {
 			h.logger.Info("Vote removal attempt on non-existent vote",
 				logs.WithUserID(userID),
 				logs.WithFeatureID(featureID),
 				logs.WithMethod(c.Request.Method),
 				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
 				logs.WithStatusCode(http.StatusNotFound))
 			c.JSON(http.StatusNotFound, gin.H{"error": "Vote not found"})
 			return
@@ -251,6 +630,7 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove vote"})
 		return
@@ -264,6 +644,7 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated feature"})
 		return
@@ -275,6 +656,7 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 		logs.WithVoteCount(updatedFeature.VoteCount),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK))
 
 	c.JSON(http.StatusOK, gin.H{
@@ -285,140 +667,776 @@ func (h *VoteHandler) RemoveVoteFromFeature(c *gin.Context) {
 	})
 }
 
-// GetUserVotes godoc
-// @Summary Get user's votes
-// @Description Get all votes made by the authenticated user
+// RemoveVotes godoc
+// @Summary Remove votes from several features
+// @Description Remove the authenticated user's votes from the given features in one transaction; features without a vote are ignored
 // @Tags votes
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "User's votes"
+// @Param request body votes.BulkRemoveRequest true "Feature IDs"
+// @Success 200 {object} map[string]interface{} "Number of votes removed"
+// @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 401 {object} map[string]interface{} "Unauthorized"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
-// @Router /votes/my [get]
-func (h *VoteHandler) GetUserVotes(c *gin.Context) {
-	h.logger.Info("Get user votes request started",
+// @Router /votes/remove [post]
+func (h *VoteHandler) RemoveVotes(c *gin.Context) {
+	h.logger.Info("Bulk vote removal request started",
 		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
 
 	userID, exists := getUserID(c)
 	if !exists {
-		h.logger.Warning("Get user votes attempt without authentication",
+		h.logger.Warning("Bulk vote removal attempt without authentication",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	h.logger.Debug("Fetching user's votes",
-		logs.WithUserID(userID),
-		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+	var req votes.BulkRemoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Bulk vote removal request validation failed", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest))
+		c.JSON(http.StatusBadRequest, bindErrorResponse(err, &req))
+		return
+	}
 
-	votesList, err := h.voteRepo.GetUserVotes(userID)
+	removed, err := h.voteRepo.RemoveVotes(userID, req.FeatureIDs)
 	if err != nil {
-		h.logger.Error("Failed to get user votes from database", err,
+		h.logger.Error("Failed to remove votes from database", err,
 			logs.WithUserID(userID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusInternalServerError))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user votes"})
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("feature_ids", req.FeatureIDs))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove votes"})
 		return
 	}
 
-	h.logger.Info("User votes retrieved successfully",
+	h.logger.Info("Votes removed successfully",
 		logs.WithUserID(userID),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK),
-		logs.WithMetadata("vote_count", len(votesList)))
+		logs.WithMetadata("requested_count", len(req.FeatureIDs)),
+		logs.WithMetadata("removed_count", removed))
 
 	c.JSON(http.StatusOK, gin.H{
-		"votes": votesList,
-		"count": len(votesList),
+		"message": "Votes removed successfully",
+		"removed": removed,
 	})
 }
 
-// ToggleVote godoc
-// @Summary Toggle vote for a feature
-// @Description Add vote if not voted, remove vote if already voted
+// maxVoteStatusIDs caps how many features one vote status lookup may ask about
+const maxVoteStatusIDs = 100
+
+// GetVoteStatus godoc
+// @Summary Check which features the user has voted on
+// @Description Report for each of up to 100 comma-separated feature IDs whether the authenticated user has voted on it
 // @Tags votes
-// @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param id path int true "Feature ID"
-// @Success 200 {object} map[string]interface{} "Vote toggled successfully"
+// @Param feature_ids query string true "Comma-separated feature IDs, e.g. 1,2,3"
+// @Success 200 {object} map[string]interface{} "Map of feature ID to whether the user voted"
 // @Failure 400 {object} map[string]interface{} "Bad request"
 // @Failure 401 {object} map[string]interface{} "Unauthorized"
-// @Failure 404 {object} map[string]interface{} "Feature not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
-// @Router /features/{id}/toggle-vote [post]
-func (h *VoteHandler) ToggleVote(c *gin.Context) {
-	h.logger.Info("Toggle vote request started",
-		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
-
-	idStr := c.Param("id")
-	featureID, err := strconv.Atoi(idStr)
-	if err != nil {
-		h.logger.Warning("Invalid feature ID for toggle vote",
-			logs.WithMethod(c.Request.Method),
-			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusBadRequest),
-			logs.WithMetadata("provided_id", idStr))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
-		return
-	}
-
+// @Router /votes/status [get]
+func (h *VoteHandler) GetVoteStatus(c *gin.Context) {
 	userID, exists := getUserID(c)
 	if !exists {
-		h.logger.Warning("Toggle vote attempt without authentication",
-			logs.WithFeatureID(featureID),
+		h.logger.Warning("Vote status lookup without authentication",
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusUnauthorized))
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	h.logger.Info("Processing toggle vote request",
-		logs.WithUserID(userID),
-		logs.WithFeatureID(featureID),
-		logs.WithMethod(c.Request.Method),
-		logs.WithPath(c.Request.URL.Path))
+	idsStr := c.Query("feature_ids")
+	if strings.TrimSpace(idsStr) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "feature_ids is required"})
+		return
+	}
 
-	// Check if feature exists
-	exists, err = h.featureRepo.FeatureExists(featureID)
+	parts := strings.Split(idsStr, ",")
+	if len(parts) > maxVoteStatusIDs {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("feature_ids must contain at most %d IDs", maxVoteStatusIDs)})
+		return
+	}
+
+	ids := make([]int, 0, len(parts))
+	seen := make(map[int]bool, len(parts))
+	for _, part := range parts {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || id < 1 {
+			h.logger.Warning("Invalid feature ID in vote status lookup",
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("feature_ids", idsStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+			return
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	voted, err := h.voteRepo.HasUserVotedBulk(userID, ids)
 	if err != nil {
-		h.logger.Error("Failed to check feature existence for toggle vote", err,
+		h.logger.Error("Failed to get vote status from database", err,
 			logs.WithUserID(userID),
-			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusInternalServerError))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("feature_ids", ids))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get vote status"})
 		return
 	}
-	if !exists {
-		h.logger.Info("Toggle vote attempt on non-existent feature",
-			logs.WithUserID(userID),
-			logs.WithFeatureID(featureID),
-			logs.WithMethod(c.Request.Method),
+
+	c.JSON(http.StatusOK, gin.H{"votes": voted})
+}
+
+// UndoLastVote godoc
+// @Summary Undo the most recent vote
+// @Description Remove the authenticated user's most recent vote if their last vote action was casting it and it happened within the undo window
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Vote undone"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 404 {object} map[string]interface{} "Nothing to undo"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /votes/undo-last [post]
+func (h *VoteHandler) UndoLastVote(c *gin.Context) {
+	h.logger.Info("Undo last vote request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Undo last vote attempt without authentication",
+			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
-			logs.WithStatusCode(http.StatusNotFound))
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	action, err := h.voteRepo.GetLastVoteAction(userID)
+	if err != nil {
+		if err.Error() == "vote action not found" {
+			h.logger.Info("No vote action to undo",
+				logs.WithUserID(userID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Nothing to undo"})
+			return
+		}
+
+		h.logger.Error("Failed to get last vote action from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to undo vote"})
+		return
+	}
+
+	if action.Action != votes.ActionAdd || time.Since(action.CreatedAt) > h.undoWindow {
+		h.logger.Info("Last vote action cannot be undone",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(action.FeatureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusNotFound),
+			logs.WithMetadata("action", action.Action),
+			logs.WithMetadata("acted_at", action.CreatedAt))
+		c.JSON(http.StatusNotFound, gin.H{"error": "Nothing to undo"})
+		return
+	}
+
+	err = h.voteRepo.UndoVote(userID, action.FeatureID, action.Category)
+	if err != nil {
+		if err.Error() == "vote not found" {
+			h.logger.Info("Vote to undo no longer exists",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(action.FeatureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound))
+			c.JSON(http.StatusNotFound, gin.H{"error": "Nothing to undo"})
+			return
+		}
+
+		h.logger.Error("Failed to undo vote in database", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(action.FeatureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to undo vote"})
+		return
+	}
+
+	h.logger.Info("Vote undone successfully",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(action.FeatureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Vote undone successfully",
+		"feature_id": action.FeatureID,
+	})
+}
+
+// GetUserVotes godoc
+// @Summary Get user's votes
+// @Description Get all votes made by the authenticated user; include=feature adds each feature's title, vote count and creation time
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param include query string false "Set to feature to embed feature details in each vote"
+// @Success 200 {object} map[string]interface{} "User's votes"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /votes/my [get]
+func (h *VoteHandler) GetUserVotes(c *gin.Context) {
+	h.logger.Info("Get user votes request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get user votes attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	include := c.Query("include")
+	if include != "" && include != "feature" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "include must be feature"})
+		return
+	}
+
+	h.logger.Debug("Fetching user's votes",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithMetadata("include", include))
+
+	if include == "feature" {
+		h.getUserVotesWithFeatures(c, userID)
+		return
+	}
+
+	votesList, err := h.voteRepo.GetUserVotes(userID)
+	if err != nil {
+		h.logger.Error("Failed to get user votes from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user votes"})
+		return
+	}
+
+	h.logger.Info("User votes retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("vote_count", len(votesList)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"votes": votesList,
+		"count": len(votesList),
+	})
+}
+
+// getUserVotesWithFeatures responds with the user's votes joined to their features
+func (h *VoteHandler) getUserVotesWithFeatures(c *gin.Context, userID int) {
+	votesList, err := h.voteRepo.GetUserVotesWithFeatures(userID)
+	if err != nil {
+		h.logger.Error("Failed to get user votes with features from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user votes"})
+		return
+	}
+
+	h.logger.Info("User votes with features retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("vote_count", len(votesList)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"votes": votesList,
+		"count": len(votesList),
+	})
+}
+
+// GetFeatureVoters godoc
+// @Summary Get a feature's voters
+// @Description Get the most recent votes on a feature with their voters' usernames; emails are never included
+// @Tags votes
+// @Produce json
+// @Param id path int true "Feature ID"
+// @Param limit query int false "Maximum number of voters, 1 to 100" default(20)
+// @Success 200 {object} map[string]interface{} "Feature voters, most recent first"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/voters [get]
+func (h *VoteHandler) GetFeatureVoters(c *gin.Context) {
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for voters",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	limit := 20
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > 100 {
+			h.logger.Warning("Invalid voters limit",
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusBadRequest),
+				logs.WithMetadata("limit", limitStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer between 1 and 100"})
+			return
+		}
+		limit = l
+	}
+
+	exists, err := h.featureRepo.FeatureExists(featureID)
+	if err != nil {
+		h.logger.Error("Failed to check if feature exists", err,
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature voters"})
+		return
+	}
+	if !exists {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
 		return
 	}
 
-	// Check if user has already voted
-	hasVoted, err := h.voteRepo.HasUserVoted(userID, featureID)
+	voters, err := h.voteRepo.GetVotersByFeature(featureID, limit)
+	if err != nil {
+		h.logger.Error("Failed to get feature voters from database", err,
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get feature voters"})
+		return
+	}
+	if voters == nil {
+		voters = []votes.VoterInfo{}
+	}
+
+	h.logger.Debug("Feature voters retrieved",
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("returned_count", len(voters)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"feature_id": featureID,
+		"voters":     voters,
+	})
+}
+
+// GetVotingStreak godoc
+// @Summary Get the current user's voting streak
+// @Description Get the number of consecutive days, ending today or yesterday, on which the authenticated user cast at least one vote
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Voting streak in days"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/me/streak [get]
+func (h *VoteHandler) GetVotingStreak(c *gin.Context) {
+	h.logger.Info("Get voting streak request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get voting streak attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	streak, err := h.voteRepo.GetVotingStreak(userID)
+	if err != nil {
+		h.logger.Error("Failed to get voting streak from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get voting streak"})
+		return
+	}
+
+	h.logger.Info("Voting streak retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("streak_days", streak))
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id":     userID,
+		"streak_days": streak,
+	})
+}
+
+// GetVoterPercentile godoc
+// @Summary Get the current user's percentile among voters
+// @Description Get the percentage of other users who have cast fewer votes than the authenticated user; users without votes are at the 0th percentile
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Voter percentile"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/me/voter-percentile [get]
+func (h *VoteHandler) GetVoterPercentile(c *gin.Context) {
+	h.logger.Info("Get voter percentile request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get voter percentile attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	percentile, err := h.voteRepo.GetVoterPercentile(userID)
+	if err != nil {
+		h.logger.Error("Failed to get voter percentile from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get voter percentile"})
+		return
+	}
+	// One decimal is enough for display and hides floating point noise
+	percentile = math.Round(percentile*10) / 10
+
+	h.logger.Info("Voter percentile retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("percentile", percentile))
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id":    userID,
+		"percentile": percentile,
+	})
+}
+
+// GetVoteOverlap godoc
+// @Summary Compare votes with another user
+// @Description List the features both the authenticated user and another user voted for, with counts of each user's other votes. The other user's remaining votes are never listed.
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Other user ID"
+// @Success 200 {object} votes.VoteOverlap "Vote overlap"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /users/{id}/vote-overlap [get]
+func (h *VoteHandler) GetVoteOverlap(c *gin.Context) {
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get vote overlap attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	idStr := c.Param("id")
+	otherUserID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid user ID for vote overlap",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if otherUserID == userID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot compare votes with yourself"})
+		return
+	}
+
+	overlap, err := h.voteRepo.GetVoteOverlap(userID, otherUserID)
+	if err != nil {
+		h.logger.Error("Failed to get vote overlap from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("other_user_id", otherUserID))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get vote overlap"})
+		return
+	}
+
+	h.logger.Debug("Vote overlap retrieved",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("other_user_id", otherUserID),
+		logs.WithMetadata("shared_count", overlap.SharedCount))
+
+	c.JSON(http.StatusOK, gin.H{"overlap": overlap})
+}
+
+// GetVoteImpact godoc
+// @Summary Preview the effect of removing a user's votes
+// @Description Show how each feature's vote count would change, and which vote milestones it would fall below, if all of the user's votes were removed. Nothing is changed.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "User ID"
+// @Success 200 {object} votes.VoteImpact "Vote removal impact"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Forbidden"
+// @Failure 404 {object} map[string]interface{} "User not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /admin/users/{id}/vote-impact [get]
+func (h *VoteHandler) GetVoteImpact(c *gin.Context) {
+	idStr := c.Param("id")
+	targetID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid user ID for vote impact",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	impact, err := h.voteRepo.GetVoteRemovalImpact(targetID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			h.logger.Info("Vote impact requested for non-existent user",
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusNotFound),
+				logs.WithMetadata("target_user_id", targetID))
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		h.logger.Error("Failed to get vote removal impact from database", err,
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError),
+			logs.WithMetadata("target_user_id", targetID))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get vote impact"})
+		return
+	}
+
+	h.logger.Info("Vote removal impact retrieved",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("target_user_id", targetID),
+		logs.WithMetadata("total_votes", impact.TotalVotes),
+		logs.WithMetadata("dropped_count", impact.DroppedCount))
+
+	c.JSON(http.StatusOK, impact)
+}
+
+// ToggleVote godoc
+// @Summary Toggle vote for a feature
+// @Description Add vote if not voted, remove vote if already voted
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Feature ID"
+// @Success 200 {object} map[string]interface{} "Vote toggled successfully"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 403 {object} map[string]interface{} "Voting closed"
+// @Failure 404 {object} map[string]interface{} "Feature not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/{id}/toggle-vote [post]
+func (h *VoteHandler) ToggleVote(c *gin.Context) {
+	h.logger.Info("Toggle vote request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	idStr := c.Param("id")
+	featureID, err := strconv.Atoi(idStr)
+	if err != nil {
+		h.logger.Warning("Invalid feature ID for toggle vote",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusBadRequest),
+			logs.WithMetadata("provided_id", idStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid feature ID"})
+		return
+	}
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Toggle vote attempt without authentication",
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	h.logger.Info("Processing toggle vote request",
+		logs.WithUserID(userID),
+		logs.WithFeatureID(featureID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	// Check if feature exists
+	exists, err = h.featureRepo.FeatureExists(featureID)
 	if err != nil {
+		h.logger.Error("Failed to check feature existence for toggle vote", err,
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check feature existence"})
+		return
+	}
+	if !exists {
+		h.logger.Info("Toggle vote attempt on non-existent feature",
+			logs.WithUserID(userID),
+			logs.WithFeatureID(featureID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusNotFound))
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	// Check if user has already voted, in either direction
+	existing, err := h.voteRepo.GetUserVote(userID, featureID, votes.DefaultCategory)
+	if err != nil && err.Error() != "vote not found" {
 		h.logger.Error("Failed to check user vote status for toggle", err,
 			logs.WithUserID(userID),
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check vote status"})
 		return
@@ -426,14 +1444,16 @@ func (h *VoteHandler) ToggleVote(c *gin.Context) {
 
 	var message string
 	var action string
+	hasVoted := existing != nil
 	if hasVoted {
 		// Remove vote
-		if err := h.voteRepo.RemoveVote(userID, featureID); err != nil {
+		if err := h.voteRepo.RemoveVote(userID, featureID, votes.DefaultCategory); err != nil {
 			h.logger.Error("Failed to remove vote during toggle", err,
 				logs.WithUserID(userID),
 				logs.WithFeatureID(featureID),
 				logs.WithMethod(c.Request.Method),
 				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
 				logs.WithStatusCode(http.StatusInternalServerError))
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove vote"})
 			return
@@ -442,19 +1462,64 @@ func (h *VoteHandler) ToggleVote(c *gin.Context) {
 		action = "removed"
 		hasVoted = false
 	} else {
+		reached, err := h.quotaReached(userID)
+		if err != nil {
+			h.logger.Error("Failed to check user vote quota for toggle", err,
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusInternalServerError))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check vote quota"})
+			return
+		}
+		if reached {
+			h.logger.Info("Toggle vote attempt beyond quota",
+				logs.WithUserID(userID),
+				logs.WithFeatureID(featureID),
+				logs.WithMethod(c.Request.Method),
+				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
+				logs.WithStatusCode(http.StatusForbidden),
+				logs.WithMetadata("vote_quota", h.voteQuota))
+			c.JSON(http.StatusForbidden, gin.H{"error": "Vote quota reached"})
+			return
+		}
+
 		// Add vote
-		if err := h.voteRepo.AddVote(userID, featureID); err != nil {
+		inserted, err := h.voteRepo.AddVote(userID, featureID, votes.DefaultCategory, votes.Upvote, nil)
+		if err != nil {
+			if errors.Is(err, votes.ErrVotingClosed) {
+				h.logger.Info("Vote toggle rejected on feature closed for voting",
+					logs.WithUserID(userID),
+					logs.WithFeatureID(featureID),
+					logs.WithMethod(c.Request.Method),
+					logs.WithPath(c.Request.URL.Path),
+					logs.WithRequestID(requestID(c)),
+					logs.WithStatusCode(http.StatusForbidden))
+				c.JSON(http.StatusForbidden, gin.H{"error": "Voting on this feature has closed"})
+				return
+			}
 			h.logger.Error("Failed to add vote during toggle", err,
 				logs.WithUserID(userID),
 				logs.WithFeatureID(featureID),
 				logs.WithMethod(c.Request.Method),
 				logs.WithPath(c.Request.URL.Path),
+				logs.WithRequestID(requestID(c)),
 				logs.WithStatusCode(http.StatusInternalServerError))
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add vote"})
 			return
 		}
-		message = "Vote added successfully"
-		action = "added"
+		if inserted {
+			h.metrics.VoteCast()
+			message = "Vote added successfully"
+			action = "added"
+		} else {
+			// A concurrent or retried toggle stored the vote first
+			message = "Vote already recorded"
+			action = "unchanged"
+		}
 		hasVoted = true
 	}
 
@@ -466,25 +1531,133 @@ func (h *VoteHandler) ToggleVote(c *gin.Context) {
 			logs.WithFeatureID(featureID),
 			logs.WithMethod(c.Request.Method),
 			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
 			logs.WithStatusCode(http.StatusInternalServerError))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated feature"})
 		return
 	}
 
+	if action == "added" {
+		h.recordMilestone(c, userID, updatedFeature)
+	}
+
 	h.logger.Info("Vote toggled successfully",
 		logs.WithUserID(userID),
 		logs.WithFeatureID(featureID),
 		logs.WithVoteCount(updatedFeature.VoteCount),
 		logs.WithMethod(c.Request.Method),
 		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
 		logs.WithStatusCode(http.StatusOK),
 		logs.WithMetadata("vote_action", action),
 		logs.WithMetadata("has_voted", hasVoted))
 
-	c.JSON(http.StatusOK, gin.H{
+	response := gin.H{
 		"message":    message,
 		"feature_id": featureID,
 		"vote_count": updatedFeature.VoteCount,
 		"has_voted":  hasVoted,
+	}
+	if hasVoted {
+		if warnings := h.quotaWarnings(c, userID); warnings != nil {
+			response["warnings"] = warnings
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetVotableFeatures godoc
+// @Summary Get features the user can still vote on
+// @Description Get a paginated list of features the authenticated user has not voted for, leaving out expired, completed and rejected features; empty when the vote quota is reached
+// @Tags votes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} map[string]interface{} "Votable features"
+// @Failure 401 {object} map[string]interface{} "Unauthorized"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /features/votable [get]
+func (h *VoteHandler) GetVotableFeatures(c *gin.Context) {
+	h.logger.Info("Get votable features request started",
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)))
+
+	userID, exists := getUserID(c)
+	if !exists {
+		h.logger.Warning("Get votable features attempt without authentication",
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusUnauthorized))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, perPage := parsePagination(c)
+
+	reached, err := h.quotaReached(userID)
+	if err != nil {
+		h.logger.Error("Failed to check user vote quota", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check vote quota"})
+		return
+	}
+
+	if reached {
+		h.logger.Info("Votable features requested with quota reached",
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusOK),
+			logs.WithMetadata("vote_quota", h.voteQuota))
+		c.JSON(http.StatusOK, gin.H{
+			"features":      []features.Feature{},
+			"total":         0,
+			"page":          page,
+			"per_page":      perPage,
+			"quota_reached": true,
+		})
+		return
+	}
+
+	featuresList, total, err := h.featureRepo.GetVotable(userID, page, perPage)
+	if err != nil {
+		h.logger.Error("Failed to get votable features from database", err,
+			logs.WithUserID(userID),
+			logs.WithMethod(c.Request.Method),
+			logs.WithPath(c.Request.URL.Path),
+			logs.WithRequestID(requestID(c)),
+			logs.WithStatusCode(http.StatusInternalServerError))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get votable features"})
+		return
+	}
+	if featuresList == nil {
+		featuresList = []features.Feature{}
+	}
+
+	h.logger.Info("Votable features retrieved successfully",
+		logs.WithUserID(userID),
+		logs.WithMethod(c.Request.Method),
+		logs.WithPath(c.Request.URL.Path),
+		logs.WithRequestID(requestID(c)),
+		logs.WithStatusCode(http.StatusOK),
+		logs.WithMetadata("total_features", total),
+		logs.WithMetadata("returned_count", len(featuresList)))
+
+	c.JSON(http.StatusOK, gin.H{
+		"features":      featuresList,
+		"total":         total,
+		"page":          page,
+		"per_page":      perPage,
+		"quota_reached": false,
 	})
-}
\ No newline at end of file
+}
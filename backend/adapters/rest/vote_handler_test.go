@@ -2,16 +2,24 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	logsmocks "github.com/feature-voting-platform/backend/adapters/logs/mocks"
+	"github.com/feature-voting-platform/backend/domain/activity"
+	activitymocks "github.com/feature-voting-platform/backend/domain/activity/mocks"
+	"github.com/feature-voting-platform/backend/domain/features"
 	featuresmocks "github.com/feature-voting-platform/backend/domain/features/mocks"
 	"github.com/feature-voting-platform/backend/domain/votes"
 	votesmocks "github.com/feature-voting-platform/backend/domain/votes/mocks"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -24,6 +32,7 @@ func TestVoteHandler_VoteForFeature_Simple(t *testing.T) {
 		name           string
 		userID         int
 		featureID      string
+		requestBody    string
 		setupMocks     func(*featuresmocks.MockRepository, *votesmocks.MockRepository, *logsmocks.MockLogger)
 		expectedStatus int
 		expectedBody   map[string]interface{}
@@ -34,15 +43,140 @@ func TestVoteHandler_VoteForFeature_Simple(t *testing.T) {
 			featureID: "1",
 			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
 				featureRepo.On("FeatureExists", 1).Return(true, nil)
-				voteRepo.On("HasUserVoted", 1, 1).Return(false, nil)
-				voteRepo.On("AddVote", 1, 1).Return(nil)
-				// Simple logger expectations - just expect any calls
-				logger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
-				logger.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+				voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, (*string)(nil)).Return(true, nil)
+				featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 1, HasUserVoted: true}, nil)
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: map[string]interface{}{
-				"message": "Vote cast successfully",
+				"message": "Vote added successfully",
+			},
+		},
+		{
+			name:        "successful vote with reason",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"reason": "  Our team needs this for compliance  "}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+				voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, stringPtr("Our team needs this for compliance")).Return(true, nil)
+				featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 1, HasUserVoted: true}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Vote added successfully",
+			},
+		},
+		{
+			name:        "downvote",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"direction": "down"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+				voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Downvote, (*string)(nil)).Return(true, nil)
+				featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: -1, HasUserVoted: true, UserVote: votes.Downvote}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"value":      float64(-1),
+				"vote_count": float64(-1),
+			},
+		},
+		{
+			name:        "same direction as the existing vote conflicts",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"direction": "down"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(&votes.Vote{Value: votes.Downvote}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"error": "You have already voted for this feature",
+			},
+		},
+		{
+			name:        "other direction switches the vote",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"direction": "down"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(&votes.Vote{Value: votes.Upvote}, nil)
+				voteRepo.On("ChangeVoteDirection", 1, 1, votes.DefaultCategory, votes.Downvote).Return(true, nil)
+				featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 3, HasUserVoted: true, UserVote: votes.Downvote}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message":    "Vote direction changed",
+				"value":      float64(-1),
+				"vote_count": float64(3),
+			},
+		},
+		{
+			name:        "switching direction after the deadline",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"direction": "up"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(&votes.Vote{Value: votes.Downvote}, nil)
+				voteRepo.On("ChangeVoteDirection", 1, 1, votes.DefaultCategory, votes.Upvote).Return(false, votes.ErrVotingClosed)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "Voting on this feature has closed",
+			},
+		},
+		{
+			name:        "unknown direction",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"direction": "sideways"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "direction must be up or down",
+			},
+		},
+		{
+			name:        "reason too long",
+			userID:      1,
+			featureID:   "1",
+			requestBody: `{"reason": "` + strings.Repeat("r", votes.MaxReasonLength+1) + `"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": "Reason must be at most 280 characters",
+			},
+		},
+		{
+			name:      "voting past the deadline",
+			userID:    1,
+			featureID: "1",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+				voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, (*string)(nil)).Return(false, votes.ErrVotingClosed)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "Voting on this feature has closed",
 			},
 		},
 	}
@@ -59,11 +193,14 @@ func TestVoteHandler_VoteForFeature_Simple(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, router := gin.CreateTestContext(w)
 
-			c.Set("user_id", tt.userID)
+			router.Use(setUserID(tt.userID))
 			router.POST("/features/:id/vote", handler.VoteForFeature)
 
 			url := "/features/" + tt.featureID + "/vote"
-			req, _ := http.NewRequest(http.MethodPost, url, nil)
+			req, _ := http.NewRequest(http.MethodPost, url, strings.NewReader(tt.requestBody))
+			if tt.requestBody != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
 
 			c.Request = req
 			router.ServeHTTP(w, req)
@@ -88,6 +225,7 @@ func TestVoteHandler_GetUserVotes_Simple(t *testing.T) {
 	tests := []struct {
 		name           string
 		userID         int
+		query          string
 		setupMocks     func(*featuresmocks.MockRepository, *votesmocks.MockRepository, *logsmocks.MockLogger)
 		expectedStatus int
 		checkResponse  func(*testing.T, map[string]interface{})
@@ -105,9 +243,7 @@ func TestVoteHandler_GetUserVotes_Simple(t *testing.T) {
 					},
 				}
 				voteRepo.On("GetUserVotes", 1).Return(mockVotes, nil)
-				// Simple logger expectations
-				logger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
-				logger.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+				expectAnyLogs(logger)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, response map[string]interface{}) {
@@ -120,6 +256,43 @@ func TestVoteHandler_GetUserVotes_Simple(t *testing.T) {
 				assert.Equal(t, float64(10), vote1["feature_id"])
 			},
 		},
+		{
+			name:   "include=feature embeds feature details",
+			userID: 1,
+			query:  "?include=feature",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				voteRepo.On("GetUserVotesWithFeatures", 1).Return([]votes.VoteWithFeature{
+					{
+						Vote:    votes.Vote{ID: 1, UserID: 1, FeatureID: 10, Value: 1, CreatedAt: now},
+						Feature: votes.VotedFeature{ID: 10, Title: "Dark mode", VoteCount: 12, CreatedAt: now},
+					},
+				}, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				votes := response["votes"].([]interface{})
+				require.Len(t, votes, 1)
+
+				vote1 := votes[0].(map[string]interface{})
+				assert.Equal(t, float64(10), vote1["feature_id"])
+				feature := vote1["feature"].(map[string]interface{})
+				assert.Equal(t, "Dark mode", feature["title"])
+				assert.Equal(t, float64(12), feature["vote_count"])
+			},
+		},
+		{
+			name:   "unknown include value",
+			userID: 1,
+			query:  "?include=comments",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "include must be feature", response["error"])
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -134,10 +307,10 @@ func TestVoteHandler_GetUserVotes_Simple(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, router := gin.CreateTestContext(w)
 
-			c.Set("user_id", tt.userID)
+			router.Use(setUserID(tt.userID))
 			router.GET("/votes", handler.GetUserVotes)
 
-			req, _ := http.NewRequest(http.MethodGet, "/votes", nil)
+			req, _ := http.NewRequest(http.MethodGet, "/votes"+tt.query, nil)
 
 			c.Request = req
 			router.ServeHTTP(w, req)
@@ -151,4 +324,868 @@ func TestVoteHandler_GetUserVotes_Simple(t *testing.T) {
 			tt.checkResponse(t, response)
 		})
 	}
-}
\ No newline at end of file
+}
+func TestVoteHandler_GetVotableFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		voteQuota      int
+		setupMocks     func(*featuresmocks.MockRepository, *votesmocks.MockRepository, *logsmocks.MockLogger)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:      "under quota returns unvoted features",
+			voteQuota: 3,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				voteRepo.On("CountByUser", 1).Return(2, nil)
+				featureRepo.On("GetVotable", 1, 1, 10).Return([]features.Feature{
+					{ID: 4, Title: "Dark mode", Status: features.StatusOpen},
+					{ID: 5, Title: "Export to CSV", Status: features.StatusPlanned},
+				}, 2, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, false, response["quota_reached"])
+				assert.Equal(t, float64(2), response["total"])
+				require.Len(t, response["features"], 2)
+				for _, f := range response["features"].([]interface{}) {
+					feature := f.(map[string]interface{})
+					assert.Equal(t, false, feature["expired"])
+					assert.NotContains(t, []string{features.StatusCompleted, features.StatusRejected}, feature["status"])
+				}
+			},
+		},
+		{
+			name:      "every unvoted feature closed returns empty list under quota",
+			voteQuota: 3,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				voteRepo.On("CountByUser", 1).Return(1, nil)
+				featureRepo.On("GetVotable", 1, 1, 10).Return(nil, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, false, response["quota_reached"])
+				assert.Equal(t, float64(0), response["total"])
+				assert.Equal(t, []interface{}{}, response["features"])
+			},
+		},
+		{
+			name:      "at quota returns empty list",
+			voteQuota: 3,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				voteRepo.On("CountByUser", 1).Return(3, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, true, response["quota_reached"])
+				assert.Equal(t, float64(0), response["total"])
+				assert.Len(t, response["features"], 0)
+			},
+		},
+		{
+			name:      "no quota configured skips the count",
+			voteQuota: 0,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository, logger *logsmocks.MockLogger) {
+				featureRepo.On("GetVotable", 1, 1, 10).Return(nil, 0, nil)
+				expectAnyLogs(logger)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, false, response["quota_reached"])
+				assert.Len(t, response["features"], 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger).WithVoteQuota(tt.voteQuota)
+
+			tt.setupMocks(featureRepo, voteRepo, logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/features/votable", handler.GetVotableFeatures)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/votable", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestVoteHandler_VoteForFeature_QuotaReached(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	featureRepo := featuresmocks.NewMockRepository(t)
+	voteRepo := votesmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewVoteHandler(featureRepo, voteRepo, logger).WithVoteQuota(2)
+
+	featureRepo.On("FeatureExists", 1).Return(true, nil)
+	voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+	voteRepo.On("CountByUser", 1).Return(2, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.Use(setUserID(1))
+	router.POST("/features/:id/vote", handler.VoteForFeature)
+
+	req, _ := http.NewRequest(http.MethodPost, "/features/1/vote", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "Vote quota reached", response["error"])
+}
+
+func TestVoteHandler_VoteForFeature_QuotaWarning(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		countAfter    int
+		wantWarnings  bool
+		wantRemaining float64
+	}{
+		{
+			name:          "near the quota",
+			countAfter:    8,
+			wantWarnings:  true,
+			wantRemaining: 2,
+		},
+		{
+			name:         "far from the quota",
+			countAfter:   3,
+			wantWarnings: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger).
+				WithVoteQuota(10).
+				WithQuotaWarningMargin(2)
+
+			featureRepo.On("FeatureExists", 1).Return(true, nil)
+			voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+			voteRepo.On("CountByUser", 1).Return(tt.countAfter-1, nil).Once()
+			voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, (*string)(nil)).Return(true, nil)
+			voteRepo.On("CountByUser", 1).Return(tt.countAfter, nil).Once()
+			featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 1, HasUserVoted: true}, nil)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/features/:id/vote", handler.VoteForFeature)
+
+			req, _ := http.NewRequest(http.MethodPost, "/features/1/vote", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			if !tt.wantWarnings {
+				assert.NotContains(t, response, "warnings")
+				return
+			}
+
+			warnings := response["warnings"].([]interface{})
+			require.Len(t, warnings, 1)
+			warning := warnings[0].(map[string]interface{})
+			assert.Equal(t, WarningApproachingVoteQuota, warning["code"])
+			assert.Equal(t, tt.wantRemaining, warning["remaining"])
+		})
+	}
+}
+
+func TestVoteHandler_VoteForFeature_RecordsMilestone(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	featureRepo := featuresmocks.NewMockRepository(t)
+	voteRepo := votesmocks.NewMockRepository(t)
+	activityRepo := activitymocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewVoteHandler(featureRepo, voteRepo, logger).WithActivity(activityRepo)
+
+	featureRepo.On("FeatureExists", 1).Return(true, nil)
+	voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+	voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, (*string)(nil)).Return(true, nil)
+	featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 10, HasUserVoted: true}, nil)
+	activityRepo.On("Record", activity.Event{
+		Type:      activity.TypeVoteMilestone,
+		ActorID:   intPtr(1),
+		FeatureID: 1,
+		Milestone: intPtr(10),
+	}).Return(nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.Use(setUserID(1))
+	router.POST("/features/:id/vote", handler.VoteForFeature)
+
+	req, _ := http.NewRequest(http.MethodPost, "/features/1/vote", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestVoteHandler_VoteForFeature_CountsMetric(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	featureRepo := featuresmocks.NewMockRepository(t)
+	voteRepo := votesmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	metrics := NewMetrics(prometheus.NewRegistry())
+	handler := NewVoteHandler(featureRepo, voteRepo, logger).WithMetrics(metrics)
+
+	featureRepo.On("FeatureExists", 1).Return(true, nil)
+	voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found"))
+	voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, (*string)(nil)).Return(true, nil)
+	featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 1, HasUserVoted: true}, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.Use(setUserID(1))
+	router.POST("/features/:id/vote", handler.VoteForFeature)
+
+	req, _ := http.NewRequest(http.MethodPost, "/features/1/vote", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.votesCast))
+}
+
+func TestVoteHandler_VoteForFeature_ConflictingInsert(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	featureRepo := featuresmocks.NewMockRepository(t)
+	voteRepo := votesmocks.NewMockRepository(t)
+	activityRepo := activitymocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	metrics := NewMetrics(prometheus.NewRegistry())
+	handler := NewVoteHandler(featureRepo, voteRepo, logger).WithMetrics(metrics).WithActivity(activityRepo)
+
+	// A concurrent request stores a downvote between the duplicate check and the insert
+	featureRepo.On("FeatureExists", 1).Return(true, nil)
+	voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(nil, errors.New("vote not found")).Once()
+	voteRepo.On("AddVote", 1, 1, votes.DefaultCategory, votes.Upvote, (*string)(nil)).Return(false, nil)
+	voteRepo.On("GetUserVote", 1, 1, votes.DefaultCategory).Return(&votes.Vote{UserID: 1, FeatureID: 1, Category: votes.DefaultCategory, Value: votes.Downvote}, nil).Once()
+	featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 10, HasUserVoted: true}, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.Use(setUserID(1))
+	router.POST("/features/:id/vote", handler.VoteForFeature)
+
+	req, _ := http.NewRequest(http.MethodPost, "/features/1/vote", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Vote already recorded", response["message"])
+	assert.Equal(t, float64(votes.Downvote), response["value"])
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.votesCast))
+	activityRepo.AssertNotCalled(t, "Record", mock.Anything)
+}
+
+func TestVoteHandler_VoteForFeature_Categories(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMocks     func(*featuresmocks.MockRepository, *votesmocks.MockRepository)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:        "vote in a second category on the same feature",
+			requestBody: `{"category": "would_pay"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, "would_pay").Return(nil, errors.New("vote not found"))
+				voteRepo.On("AddVote", 1, 1, "would_pay", votes.Upvote, (*string)(nil)).Return(true, nil)
+				featureRepo.On("GetByID", 1, intPtr(1)).Return(&features.Feature{ID: 1, VoteCount: 2, HasUserVoted: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message":  "Vote added successfully",
+				"category": "would_pay",
+			},
+		},
+		{
+			name:        "already voted in that category",
+			requestBody: `{"category": "want_it"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetUserVote", 1, 1, "want_it").Return(&votes.Vote{Category: "want_it", Value: votes.Upvote}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"error": "You have already voted for this feature",
+			},
+		},
+		{
+			name:        "unknown category",
+			requestBody: `{"category": "maybe_later"}`,
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "Unknown vote category",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger).
+				WithVoteCategories([]string{"want_it", "would_pay"})
+
+			tt.setupMocks(featureRepo, voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/features/:id/vote", handler.VoteForFeature)
+
+			req, _ := http.NewRequest(http.MethodPost, "/features/1/vote", strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+		})
+	}
+}
+
+func TestVoteHandler_GetVoteOverlap(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		otherUserID    string
+		setupMocks     func(*votesmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:        "partial overlap",
+			otherUserID: "2",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetVoteOverlap", 1, 2).Return(&votes.VoteOverlap{
+					UserID:         1,
+					OtherUserID:    2,
+					SharedFeatures: []features.Feature{{ID: 4, Title: "Dark mode", HasUserVoted: true}},
+					SharedCount:    1,
+					OnlyUserCount:  2,
+					OnlyOtherCount: 5,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				overlap := response["overlap"].(map[string]interface{})
+				shared := overlap["shared_features"].([]interface{})
+				require.Len(t, shared, 1)
+				assert.Equal(t, float64(4), shared[0].(map[string]interface{})["id"])
+				assert.Equal(t, float64(1), overlap["shared_count"])
+				assert.Equal(t, float64(2), overlap["only_user_count"])
+				assert.Equal(t, float64(5), overlap["only_other_count"])
+			},
+		},
+		{
+			name:           "comparing with yourself",
+			otherUserID:    "1",
+			setupMocks:     func(voteRepo *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Cannot compare votes with yourself", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger)
+
+			tt.setupMocks(voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/users/:id/vote-overlap", handler.GetVoteOverlap)
+
+			req, _ := http.NewRequest(http.MethodGet, "/users/"+tt.otherUserID+"/vote-overlap", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestVoteHandler_UndoLastVote(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*votesmocks.MockRepository)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "undo within window",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetLastVoteAction", 1).Return(&votes.VoteAction{
+					UserID:    1,
+					FeatureID: 4,
+					Category:  votes.DefaultCategory,
+					Action:    votes.ActionAdd,
+					CreatedAt: time.Now().Add(-5 * time.Second),
+				}, nil)
+				voteRepo.On("UndoVote", 1, 4, votes.DefaultCategory).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Vote undone successfully", response["message"])
+				assert.Equal(t, float64(4), response["feature_id"])
+			},
+		},
+		{
+			name: "nothing to undo",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetLastVoteAction", 1).Return(nil, errors.New("vote action not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Nothing to undo", response["error"])
+			},
+		},
+		{
+			name: "vote outside window",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetLastVoteAction", 1).Return(&votes.VoteAction{
+					UserID:    1,
+					FeatureID: 4,
+					Action:    votes.ActionAdd,
+					CreatedAt: time.Now().Add(-time.Minute),
+				}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Nothing to undo", response["error"])
+			},
+		},
+		{
+			name: "last action was a removal",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetLastVoteAction", 1).Return(&votes.VoteAction{
+					UserID:    1,
+					FeatureID: 4,
+					Action:    votes.ActionRemove,
+					CreatedAt: time.Now(),
+				}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "Nothing to undo", response["error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger).WithUndoWindow(30 * time.Second)
+
+			tt.setupMocks(voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.POST("/votes/undo-last", handler.UndoLastVote)
+
+			req, _ := http.NewRequest(http.MethodPost, "/votes/undo-last", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			tt.checkResponse(t, response)
+		})
+	}
+}
+
+func TestVoteHandler_GetVotingStreak(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	featureRepo := featuresmocks.NewMockRepository(t)
+	voteRepo := votesmocks.NewMockRepository(t)
+	logger := logsmocks.NewMockLogger(t)
+	handler := NewVoteHandler(featureRepo, voteRepo, logger)
+
+	voteRepo.On("GetVotingStreak", 1).Return(4, nil)
+	expectAnyLogs(logger)
+
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+	router.Use(setUserID(1))
+	router.GET("/auth/me/streak", handler.GetVotingStreak)
+
+	req, _ := http.NewRequest(http.MethodGet, "/auth/me/streak", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, float64(4), response["streak_days"])
+}
+
+func TestVoteHandler_GetVoterPercentile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		percentile     float64
+		wantPercentile float64
+	}{
+		{name: "user without votes", percentile: 0, wantPercentile: 0},
+		{name: "rounded to one decimal", percentile: 66.66666, wantPercentile: 66.7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger)
+
+			voteRepo.On("GetVoterPercentile", 1).Return(tt.percentile, nil)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/auth/me/voter-percentile", handler.GetVoterPercentile)
+
+			req, _ := http.NewRequest(http.MethodGet, "/auth/me/voter-percentile", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+			assert.Equal(t, float64(1), response["user_id"])
+			assert.Equal(t, tt.wantPercentile, response["percentile"])
+		})
+	}
+}
+
+func TestVoteHandler_GetVoteImpact(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		targetID       string
+		setupMocks     func(*votesmocks.MockRepository)
+		expectedStatus int
+	}{
+		{
+			name:     "admin previews the impact",
+			role:     "admin",
+			targetID: "7",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetVoteRemovalImpact", 7).Return(&votes.VoteImpact{
+					UserID:       7,
+					TotalVotes:   2,
+					DroppedCount: 1,
+					Features: []votes.FeatureVoteImpact{
+						{FeatureID: 1, Title: "Dark mode", CurrentVoteCount: 25, VotesRemoved: 2, ProjectedVoteCount: 23, DropsBelow: []int{25}},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "non-admin is forbidden",
+			role:           "user",
+			targetID:       "7",
+			setupMocks:     func(voteRepo *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:     "unknown user",
+			role:     "admin",
+			targetID: "99",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("GetVoteRemovalImpact", 99).Return(nil, errors.New("user not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid user ID",
+			role:           "admin",
+			targetID:       "abc",
+			setupMocks:     func(voteRepo *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger)
+
+			tt.setupMocks(voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1), setRole(tt.role), RequireRole("admin"))
+			router.GET("/admin/users/:id/vote-impact", handler.GetVoteImpact)
+
+			req, _ := http.NewRequest(http.MethodGet, "/admin/users/"+tt.targetID+"/vote-impact", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var impact votes.VoteImpact
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &impact))
+				assert.Equal(t, 1, impact.DroppedCount)
+				require.Len(t, impact.Features, 1)
+				assert.Equal(t, []int{25}, impact.Features[0].DropsBelow)
+			}
+		})
+	}
+}
+
+func TestVoteHandler_GetVoteStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tooMany := make([]string, maxVoteStatusIDs+1)
+	for i := range tooMany {
+		tooMany[i] = strconv.Itoa(i + 1)
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*votesmocks.MockRepository)
+		expectedStatus int
+		expectedVotes  map[string]bool
+	}{
+		{
+			name:  "returns status for each requested feature",
+			query: "?feature_ids=1,2,3,2",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("HasUserVotedBulk", 1, []int{1, 2, 3}).Return(map[int]bool{1: true, 2: false, 3: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedVotes:  map[string]bool{"1": true, "2": false, "3": true},
+		},
+		{
+			name:           "missing feature_ids",
+			query:          "",
+			setupMocks:     func(voteRepo *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid feature ID",
+			query:          "?feature_ids=1,abc",
+			setupMocks:     func(voteRepo *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "too many feature IDs",
+			query:          "?feature_ids=" + strings.Join(tooMany, ","),
+			setupMocks:     func(voteRepo *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "repository error",
+			query: "?feature_ids=1",
+			setupMocks: func(voteRepo *votesmocks.MockRepository) {
+				voteRepo.On("HasUserVotedBulk", 1, []int{1}).Return(nil, errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featuresmocks.NewMockRepository(t), voteRepo, logger)
+
+			tt.setupMocks(voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.Use(setUserID(1))
+			router.GET("/votes/status", handler.GetVoteStatus)
+
+			req, _ := http.NewRequest(http.MethodGet, "/votes/status"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedVotes != nil {
+				var response struct {
+					Votes map[string]bool `json:"votes"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedVotes, response.Votes)
+			}
+		})
+	}
+}
+
+func TestVoteHandler_GetFeatureVoters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*featuresmocks.MockRepository, *votesmocks.MockRepository)
+		expectedStatus int
+		checkBody      func(*testing.T, string)
+	}{
+		{
+			name:  "default limit",
+			query: "",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetVotersByFeature", 1, 20).Return([]votes.VoterInfo{
+					{UserID: 3, Username: "carol", Category: "default", Value: 1, VotedAt: now},
+					{UserID: 2, Username: "bob", Category: "default", Value: -1, VotedAt: now.Add(-time.Hour)},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkBody: func(t *testing.T, body string) {
+				var response struct {
+					Voters []votes.VoterInfo `json:"voters"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(body), &response))
+				require.Len(t, response.Voters, 2)
+				assert.Equal(t, "carol", response.Voters[0].Username)
+				assert.NotContains(t, body, "email")
+			},
+		},
+		{
+			name:  "no votes is an empty list",
+			query: "?limit=5",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(true, nil)
+				voteRepo.On("GetVotersByFeature", 1, 5).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkBody: func(t *testing.T, body string) {
+				assert.Contains(t, body, `"voters":[]`)
+			},
+		},
+		{
+			name:           "invalid limit",
+			query:          "?limit=0",
+			setupMocks:     func(*featuresmocks.MockRepository, *votesmocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "feature not found",
+			query: "",
+			setupMocks: func(featureRepo *featuresmocks.MockRepository, voteRepo *votesmocks.MockRepository) {
+				featureRepo.On("FeatureExists", 1).Return(false, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureRepo := featuresmocks.NewMockRepository(t)
+			voteRepo := votesmocks.NewMockRepository(t)
+			logger := logsmocks.NewMockLogger(t)
+			handler := NewVoteHandler(featureRepo, voteRepo, logger)
+
+			tt.setupMocks(featureRepo, voteRepo)
+			expectAnyLogs(logger)
+
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+			router.GET("/features/:id/voters", handler.GetFeatureVoters)
+
+			req, _ := http.NewRequest(http.MethodGet, "/features/1/voters"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.checkBody != nil {
+				tt.checkBody(t, w.Body.String())
+			}
+		})
+	}
+}
@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
 	"log"
+	"time"
 
 	"github.com/feature-voting-platform/backend/adapters/auth"
+	"github.com/feature-voting-platform/backend/adapters/jobs"
 	"github.com/feature-voting-platform/backend/adapters/logs"
 	"github.com/feature-voting-platform/backend/adapters/postgres"
 	"github.com/feature-voting-platform/backend/adapters/rest"
+	"github.com/feature-voting-platform/backend/domain/users"
 	"github.com/feature-voting-platform/backend/internal/config"
+	"github.com/feature-voting-platform/backend/migrations"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -38,30 +44,136 @@ func main() {
 	cfg := config.Load()
 
 	// Initialize logger
-	logger := logs.NewJSONLogger()
+	logLevel, ok := logs.ParseLogLevel(cfg.Server.LogLevel)
+	if !ok {
+		log.Printf("Unknown LOG_LEVEL %q, using INFO", cfg.Server.LogLevel)
+		logLevel = logs.LogLevelInfo
+	}
+	logger := logs.NewJSONLogger(logLevel)
 
 	// Test our custom logger
 	logger.Info("Testing custom logger on server startup")
 
 	// Initialize database
-	db, err := postgres.NewDatabase(cfg.Database.URL)
+	db, err := postgres.NewDatabaseWithPool(cfg.Database.URL, postgres.PoolConfig{
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: time.Duration(cfg.Database.ConnMaxLifetimeMinutes) * time.Minute,
+		ConnMaxIdleTime: time.Duration(cfg.Database.ConnMaxIdleTimeMinutes) * time.Minute,
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer db.Close()
 
+	// Bring the schema up to date before serving; a failed migration stops startup
+	if cfg.Database.AutoMigrate {
+		applied, err := migrations.Up(db.DB)
+		if err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+		logger.Info("Database migrations applied", logs.WithMetadata("applied", applied))
+	}
+
 	// Initialize repositories
 	userRepo := postgres.NewUserRepository(db)
 	featureRepo := postgres.NewFeatureRepository(db)
+	subscriptionRepo := postgres.NewSubscriptionRepository(db)
+	notificationPrefsRepo := postgres.NewNotificationPreferenceRepository(db)
+	activityRepo := postgres.NewActivityRepository(db)
+	commentRepo := postgres.NewCommentRepository(db)
+	reportRepo := postgres.NewReportRepository(db)
+	adminRepo := postgres.NewAdminRepository(db)
+
+	// Close voting on features once their deadline passes
+	if cfg.Features.ExpiryCheckSeconds > 0 {
+		expiryJob := jobs.NewFeatureExpiryJob(featureRepo, time.Duration(cfg.Features.ExpiryCheckSeconds)*time.Second, logger)
+		go expiryJob.Run(context.Background())
+	}
+
+	// Record daily vote counts for rank history
+	if cfg.Features.RankSnapshotMinutes > 0 {
+		snapshotJob := jobs.NewVoteSnapshotJob(featureRepo, time.Duration(cfg.Features.RankSnapshotMinutes)*time.Minute, logger)
+		go snapshotJob.Run(context.Background())
+	}
+
+	var attentionJob *jobs.NeedsAttentionJob
+	if cfg.Features.AttentionDigestMinutes > 0 {
+		attentionJob = jobs.NewNeedsAttentionJob(featureRepo,
+			time.Duration(cfg.Features.AttentionDigestMinutes)*time.Minute,
+			time.Duration(cfg.Features.AttentionMinAgeDays)*24*time.Hour,
+			cfg.Features.AttentionMaxVotes, logger)
+		go attentionJob.Run(context.Background())
+	}
 
 	// Initialize auth services
-	tokenService := auth.NewJWTService(cfg.JWT.Secret)
-	passwordService := auth.NewBCryptPasswordService()
+	tokenService := auth.NewJWTServiceWithTTL(cfg.JWT.Secret, time.Duration(cfg.JWT.ExpiryHours)*time.Hour).
+		WithRefreshTTL(time.Duration(cfg.JWT.RefreshExpiryHours) * time.Hour)
+	passwordService, err := auth.NewBCryptPasswordServiceWithCost(cfg.Security.BcryptCost)
+	if err != nil {
+		log.Fatalf("Invalid BCRYPT_COST: %v", err)
+	}
+
+	// Revoked tokens are shared across instances unless the in-memory store is chosen
+	var tokenBlacklist auth.TokenBlacklist = postgres.NewTokenBlacklist(db)
+	if cfg.JWT.BlacklistStore == "memory" {
+		tokenBlacklist = auth.NewMemoryTokenBlacklist()
+	}
+
+	// Failed logins are throttled per client IP; a limit of 0 disables throttling
+	var loginLimiter *auth.LoginLimiter
+	if cfg.Security.LoginRateLimit > 0 {
+		loginLimiter = auth.NewLoginLimiter(cfg.Security.LoginRateLimit, cfg.Security.LoginRateWindow)
+	}
+
+	// Prometheus collectors; handlers skip counting when metrics are disabled
+	var metrics *rest.Metrics
+	if cfg.Server.MetricsEnabled {
+		metrics = rest.NewMetrics(prometheus.DefaultRegisterer)
+	}
 
 	// Initialize handlers
-	authHandler := rest.NewAuthHandler(userRepo, tokenService, passwordService, logger)
-	featureHandler := rest.NewFeatureHandler(featureRepo, logger)
-	voteHandler := rest.NewVoteHandler(featureRepo, featureRepo, logger)
+	passwordPolicy := auth.PasswordPolicy{
+		MinLength:      cfg.Security.PasswordMinLength,
+		RequireDigit:   cfg.Security.PasswordRequireDigit,
+		RequireUpper:   cfg.Security.PasswordRequireUpper,
+		RequireSpecial: cfg.Security.PasswordRequireSpecial,
+	}
+	authHandler := rest.NewAuthHandler(userRepo, tokenService, passwordService, logger).
+		WithDisposableEmailChecker(auth.NewDisposableEmailChecker(cfg.Registration.DisposableEmailDomains)).
+		WithPasswordPolicy(passwordPolicy).
+		WithTokenBlacklist(tokenBlacklist).
+		WithProfileStats(featureRepo, featureRepo).
+		WithEmailVerification(postgres.NewVerificationTokenRepository(db), auth.NewLogVerificationSender(logger))
+	featureHandler := rest.NewFeatureHandler(featureRepo, logger).
+		WithMaxLengths(cfg.Features.MaxTitleLength, cfg.Features.MaxDescriptionLength).
+		WithMinEditInterval(time.Duration(cfg.Features.MinEditIntervalSeconds) * time.Second).
+		WithDistinctDescription(cfg.Features.RequireDistinctText).
+		WithHiddenVoteCounts(cfg.Features.HideVoteCounts).
+		WithDescriptionQuality(cfg.Features.RequireQuality, cfg.Features.MinDescriptionWords).
+		WithMinDescriptionChange(cfg.Features.MinDescriptionChange).
+		WithLinkHeaders(cfg.Server.PaginationLinks).
+		WithNewWindow(time.Duration(cfg.Features.NewWindowHours) * time.Hour).
+		WithSurgeMultiplier(cfg.Features.SurgeMultiplier).
+		WithCategoryCounts(len(cfg.Votes.Categories) > 0).
+		WithActivity(activityRepo).
+		WithMetrics(metrics)
+	if cfg.Registration.RequireEmailVerification {
+		featureHandler.WithEmailVerificationRequired(userRepo)
+	}
+	voteHandler := rest.NewVoteHandler(featureRepo, featureRepo, logger).
+		WithVoteQuota(cfg.Votes.Quota).
+		WithQuotaWarningMargin(cfg.Votes.WarningMargin).
+		WithUndoWindow(time.Duration(cfg.Votes.UndoWindowSeconds) * time.Second).
+		WithVoteCategories(cfg.Votes.Categories).
+		WithActivity(activityRepo).
+		WithMetrics(metrics)
+	userHandler := rest.NewUserHandler(userRepo, featureRepo, logger)
+	subscriptionHandler := rest.NewSubscriptionHandler(featureRepo, subscriptionRepo, logger)
+	commentHandler := rest.NewCommentHandler(featureRepo, commentRepo, logger)
+	reportHandler := rest.NewReportHandler(featureRepo, reportRepo, logger)
+	notificationHandler := rest.NewNotificationHandler(notificationPrefsRepo, logger)
+	activityHandler := rest.NewActivityHandler(activityRepo, logger)
 
 	// Setup Gin
 	if cfg.Server.Env == "production" {
@@ -71,8 +183,23 @@ func main() {
 	r := gin.Default()
 
 	// Middleware
-	r.Use(rest.CORSMiddleware())
-	r.Use(rest.LoggingMiddleware(logger))
+	r.Use(rest.CORSMiddleware(cfg.Security.CORSAllowedOrigins))
+	r.Use(rest.SecurityHeadersMiddleware(cfg.Security.HeadersEnabled, cfg.Security.HSTSMaxAgeSeconds, cfg.Security.ContentSecurityPolicy))
+	// No IP-to-country resolver ships yet, so country lists could never be enforced
+	if len(cfg.Security.GeoAllowCountries) > 0 || len(cfg.Security.GeoDenyCountries) > 0 {
+		log.Fatalf("GEOBLOCK_ALLOW_COUNTRIES and GEOBLOCK_DENY_COUNTRIES require a country resolver, and none is configured")
+	}
+	r.Use(rest.GeoBlockMiddleware(rest.NoopCountryResolver{}, cfg.Security.GeoAllowCountries, cfg.Security.GeoDenyCountries))
+	r.Use(rest.RequestIDMiddleware())
+	r.Use(rest.LoggingMiddleware(logger, cfg.Server.LogExcludedPaths...))
+	if metrics != nil {
+		r.Use(rest.MetricsMiddleware(metrics))
+	}
+	r.Use(rest.MaxInFlightMiddleware(cfg.Server.MaxInFlight))
+	r.Use(rest.MaxBodyBytesMiddleware(cfg.Server.MaxBodyBytes))
+	if cfg.Audit.Enabled {
+		r.Use(rest.AuditMiddleware(postgres.NewAuditRepository(db), logger, cfg.Audit.Methods, cfg.Audit.ExcludedPaths...))
+	}
 	r.Use(gin.Recovery())
 
 	// Health check
@@ -83,40 +210,119 @@ func main() {
 		})
 	})
 
+	if metrics != nil {
+		r.GET("/metrics", rest.MetricsHandler())
+	}
+
+	requireAuth := rest.AuthMiddlewareWithBlacklist(tokenService, tokenBlacklist, logger)
+	optionalAuth := rest.OptionalAuthMiddlewareWithBlacklist(tokenService, tokenBlacklist)
+	requireAdmin := rest.RequireRole(users.RoleAdmin)
+
 	// API routes
 	v1 := r.Group("/api/v1")
 	{
+		v1.GET("/version", rest.GetVersion)
+		v1.GET("/activity", activityHandler.GetActivity)
+
 		// Auth routes (public)
 		auth := v1.Group("/auth")
 		{
-			auth.POST("/login", authHandler.Login)
-			auth.GET("/profile", rest.AuthMiddleware(tokenService), authHandler.GetProfile)
+			auth.POST("/register", authHandler.Register)
+			auth.POST("/login", rest.LoginRateLimitMiddleware(loginLimiter, logger), authHandler.Login)
+			auth.POST("/refresh", authHandler.Refresh)
+			auth.GET("/verify", authHandler.VerifyEmail)
+			auth.POST("/logout", requireAuth, authHandler.Logout)
+			auth.GET("/profile", requireAuth, authHandler.GetProfile)
+			auth.GET("/me/streak", requireAuth, voteHandler.GetVotingStreak)
+			auth.GET("/me/voter-percentile", requireAuth, voteHandler.GetVoterPercentile)
+			auth.GET("/me/whats-new", requireAuth, userHandler.GetWhatsNew)
 		}
 
 		// Feature routes
 		features := v1.Group("/features")
 		{
 			// Public routes (with optional auth for vote status)
-			features.GET("", rest.OptionalAuthMiddleware(tokenService), featureHandler.GetFeatures)
-			features.GET("/:id", rest.OptionalAuthMiddleware(tokenService), featureHandler.GetFeature)
+			features.GET("", optionalAuth, featureHandler.GetFeatures)
+			features.GET("/:id", optionalAuth, featureHandler.GetFeature)
+			features.GET("/search", optionalAuth, featureHandler.SearchFeatures)
+			features.GET("/top", optionalAuth, featureHandler.GetTopFeatures)
+			features.GET("/surging", optionalAuth, featureHandler.GetSurgingFeatures)
+			features.GET("/trending", optionalAuth, featureHandler.GetTrendingFeatures)
+			features.GET("/team-picks", requireAuth, featureHandler.GetTeamPicks)
+			features.GET("/compare", optionalAuth, featureHandler.CompareFeatures)
+			features.GET("/:id/vote-delta", optionalAuth, featureHandler.GetVoteDelta)
+			features.GET("/:id/rank-history", featureHandler.GetRankHistory)
+			features.GET("/:id/also-voted", optionalAuth, featureHandler.GetAlsoVoted)
+			features.GET("/:id/voters", voteHandler.GetFeatureVoters)
 
 			// Protected routes
-			features.POST("", rest.AuthMiddleware(tokenService), featureHandler.CreateFeature)
-			features.PUT("/:id", rest.AuthMiddleware(tokenService), featureHandler.UpdateFeature)
-			features.DELETE("/:id", rest.AuthMiddleware(tokenService), featureHandler.DeleteFeature)
-			features.GET("/my", rest.AuthMiddleware(tokenService), featureHandler.GetMyFeatures)
+			features.POST("", requireAuth, featureHandler.CreateFeature)
+			features.PUT("/:id", requireAuth, featureHandler.UpdateFeature)
+			features.PATCH("/:id", requireAuth, featureHandler.PatchFeature)
+			features.PATCH("/:id/status", requireAuth, featureHandler.UpdateFeatureStatus)
+			features.DELETE("/:id", requireAuth, featureHandler.DeleteFeature)
+			features.POST("/:id/restore", requireAuth, requireAdmin, featureHandler.RestoreFeature)
+			features.POST("/:id/tags", requireAuth, featureHandler.AddFeatureTags)
+			features.DELETE("/:id/tags/:tag", requireAuth, featureHandler.RemoveFeatureTag)
+			features.GET("/my", requireAuth, featureHandler.GetMyFeatures)
+			features.GET("/votable", requireAuth, voteHandler.GetVotableFeatures)
 
 			// Voting routes
-			features.POST("/:id/vote", rest.AuthMiddleware(tokenService), voteHandler.VoteForFeature)
-			features.DELETE("/:id/vote", rest.AuthMiddleware(tokenService), voteHandler.RemoveVoteFromFeature)
-			features.POST("/:id/toggle-vote", rest.AuthMiddleware(tokenService), voteHandler.ToggleVote)
+			features.POST("/:id/vote", requireAuth, voteHandler.VoteForFeature)
+			features.DELETE("/:id/vote", requireAuth, voteHandler.RemoveVoteFromFeature)
+			features.POST("/:id/toggle-vote", requireAuth, voteHandler.ToggleVote)
+
+			// Subscription routes
+			features.POST("/:id/subscribe", requireAuth, subscriptionHandler.Subscribe)
+			features.DELETE("/:id/subscribe", requireAuth, subscriptionHandler.Unsubscribe)
+			features.GET("/:id/comments", commentHandler.GetComments)
+			features.POST("/:id/comments", requireAuth, commentHandler.CreateComment)
+			features.POST("/:id/report", requireAuth, reportHandler.ReportFeature)
+		}
+
+		// User routes (public)
+		users := v1.Group("/users")
+		{
+			users.GET("/:id", userHandler.GetPublicProfile)
+			users.GET("/:id/stats", userHandler.GetUserStats)
+			users.GET("/:id/vote-overlap", requireAuth, voteHandler.GetVoteOverlap)
+		}
+
+		// Admin routes
+		admin := v1.Group("/admin")
+		admin.Use(requireAuth, requireAdmin)
+		{
+			if attentionJob != nil {
+				admin.GET("/needs-attention", rest.NewAdminHandler(attentionJob, logger).GetNeedsAttention)
+			}
+			admin.GET("/stats", rest.NewAdminHandler(nil, logger).WithStats(adminRepo).GetStats)
+			admin.GET("/users/:id/vote-impact", voteHandler.GetVoteImpact)
+			admin.GET("/reports", reportHandler.GetReports)
 		}
 
 		// Vote routes
 		votes := v1.Group("/votes")
-		votes.Use(rest.AuthMiddleware(tokenService))
+		votes.Use(requireAuth)
 		{
 			votes.GET("/my", voteHandler.GetUserVotes)
+			votes.GET("/status", voteHandler.GetVoteStatus)
+			votes.POST("/remove", voteHandler.RemoveVotes)
+			votes.POST("/undo-last", voteHandler.UndoLastVote)
+		}
+
+		// Subscription routes
+		subscriptions := v1.Group("/subscriptions")
+		subscriptions.Use(requireAuth)
+		{
+			subscriptions.GET("/my", subscriptionHandler.GetMySubscriptions)
+		}
+
+		// Notification routes
+		notifications := v1.Group("/notifications")
+		notifications.Use(requireAuth)
+		{
+			notifications.GET("/preferences", notificationHandler.GetPreferences)
+			notifications.PUT("/preferences", notificationHandler.UpdatePreferences)
 		}
 	}
 
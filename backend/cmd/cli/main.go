@@ -6,9 +6,11 @@ import (
 	"log"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/feature-voting-platform/backend/adapters/auth"
 	"github.com/feature-voting-platform/backend/adapters/postgres"
+	"github.com/feature-voting-platform/backend/domain/features"
 	"github.com/feature-voting-platform/backend/domain/users"
 	"github.com/feature-voting-platform/backend/internal/config"
 )
@@ -26,40 +28,74 @@ func main() {
 
 	// Initialize repositories and services
 	userRepo := postgres.NewUserRepository(db)
-	passwordService := auth.NewBCryptPasswordService()
+	featureRepo := postgres.NewFeatureRepository(db)
+	passwordService, err := auth.NewBCryptPasswordServiceWithCost(cfg.Security.BcryptCost)
+	if err != nil {
+		log.Fatalf("Invalid BCRYPT_COST: %v", err)
+	}
+	passwordPolicy := auth.PasswordPolicy{
+		MinLength:      cfg.Security.PasswordMinLength,
+		RequireDigit:   cfg.Security.PasswordRequireDigit,
+		RequireUpper:   cfg.Security.PasswordRequireUpper,
+		RequireSpecial: cfg.Security.PasswordRequireSpecial,
+	}
 
 	// Define command line flags
 	var (
-		command  = flag.String("command", "", "Command to execute (create-user)")
+		command  = flag.String("command", "", "Command to execute (create-user, list-users, delete-user, recount-votes)")
+		id       = flag.Int("id", 0, "User ID for delete-user command")
 		name     = flag.String("name", "", "Username for create-user command")
 		email    = flag.String("email", "", "Email for create-user command")
 		password = flag.String("password", "", "Password for create-user command")
+		role     = flag.String("role", users.RoleUser, "Role for create-user command (user or admin)")
 	)
 
 	flag.Parse()
 
 	switch *command {
 	case "create-user":
-		err := createUser(userRepo, passwordService, *name, *email, *password)
+		err := createUser(userRepo, passwordService, passwordPolicy, *name, *email, *password, *role)
 		if err != nil {
 			log.Fatalf("Failed to create user: %v", err)
 		}
+	case "list-users":
+		if err := listUsers(userRepo); err != nil {
+			log.Fatalf("Failed to list users: %v", err)
+		}
+	case "delete-user":
+		if err := deleteUser(userRepo, *id); err != nil {
+			log.Fatalf("Failed to delete user: %v", err)
+		}
+	case "recount-votes":
+		if err := recountVotes(featureRepo); err != nil {
+			log.Fatalf("Failed to recount votes: %v", err)
+		}
 	default:
 		fmt.Println("Feature Voting Platform CLI")
 		fmt.Println("")
 		fmt.Println("Available commands:")
 		fmt.Println("  create-user   Create a new user")
+		fmt.Println("  list-users    List all users")
+		fmt.Println("  delete-user   Delete a user by ID")
+		fmt.Println("  recount-votes Recalculate every feature's vote count from its votes")
 		fmt.Println("")
 		fmt.Println("Usage:")
-		fmt.Println("  create-user -name=<username> -email=<email> -password=<password>")
+		fmt.Println("  create-user -name=<username> -email=<email> -password=<password> [-role=user|admin]")
+		fmt.Println("  list-users")
+		fmt.Println("  delete-user -id=<user id>")
+		fmt.Println("  recount-votes")
 		fmt.Println("")
 		fmt.Println("Examples:")
 		fmt.Println("  ./cli -command=create-user -name=john_doe -email=john@example.com -password=securepass")
+		fmt.Println("  ./cli -command=create-user -name=admin -email=admin@example.com -password=securepass -role=admin")
+		fmt.Println("  ./cli -command=list-users")
+		fmt.Println("  ./cli -command=delete-user -id=42")
+		fmt.Println("  ./cli -command=recount-votes")
 		os.Exit(1)
 	}
 }
 
-func createUser(userRepo users.Repository, passwordService auth.PasswordService, username, email, password string) error {
+func createUser(userRepo users.Repository, passwordService auth.PasswordService, passwordPolicy auth.PasswordPolicy, username, email, password, role string) error {
 	// Validate input
 	if username == "" {
 		return fmt.Errorf("username is required")
@@ -78,12 +114,15 @@ func createUser(userRepo users.Repository, passwordService auth.PasswordService,
 	if len(username) < 3 || len(username) > 50 {
 		return fmt.Errorf("username must be between 3 and 50 characters")
 	}
-	if len(password) < 6 {
-		return fmt.Errorf("password must be at least 6 characters")
+	if failed := auth.ValidatePassword(password, passwordPolicy); len(failed) > 0 {
+		return fmt.Errorf("password %s", strings.Join(failed, ", "))
 	}
 	if !strings.Contains(email, "@") {
 		return fmt.Errorf("invalid email format")
 	}
+	if !users.ValidRole(role) {
+		return fmt.Errorf("role must be %q or %q", users.RoleUser, users.RoleAdmin)
+	}
 
 	// Check if user already exists by email
 	if _, err := userRepo.GetByEmail(email); err == nil {
@@ -103,9 +142,11 @@ func createUser(userRepo users.Repository, passwordService auth.PasswordService,
 
 	// Create user
 	user := &users.User{
-		Username:     username,
-		Email:        email,
-		PasswordHash: hashedPassword,
+		Username:      username,
+		Email:         email,
+		PasswordHash:  hashedPassword,
+		Role:          role,
+		EmailVerified: true,
 	}
 
 	if err := userRepo.Create(user); err != nil {
@@ -116,7 +157,63 @@ func createUser(userRepo users.Repository, passwordService auth.PasswordService,
 	fmt.Printf("   ID: %d\n", user.ID)
 	fmt.Printf("   Username: %s\n", user.Username)
 	fmt.Printf("   Email: %s\n", user.Email)
+	fmt.Printf("   Role: %s\n", user.Role)
 	fmt.Printf("   Created: %s\n", user.CreatedAt.Format("2006-01-02 15:04:05"))
 
 	return nil
-}
\ No newline at end of file
+}
+
+func listUsers(userRepo users.Repository) error {
+	list, err := userRepo.GetAll()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tUSERNAME\tEMAIL\tCREATED")
+	for _, user := range list {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", user.ID, user.Username, user.Email, user.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d user(s)\n", len(list))
+	return nil
+}
+
+func deleteUser(userRepo users.Repository, id int) error {
+	if id < 1 {
+		return fmt.Errorf("id is required")
+	}
+
+	// Look the user up first so the confirmation can say who was removed
+	user, err := userRepo.GetByID(id)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return fmt.Errorf("no user with ID %d", id)
+		}
+		return err
+	}
+
+	if err := userRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete user from database: %w", err)
+	}
+
+	fmt.Printf("✅ User deleted successfully!\n")
+	fmt.Printf("   ID: %d\n", user.ID)
+	fmt.Printf("   Username: %s\n", user.Username)
+	fmt.Printf("   Email: %s\n", user.Email)
+
+	return nil
+}
+
+func recountVotes(featureRepo features.Repository) error {
+	corrected, err := featureRepo.RecalculateVoteCounts()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Vote counts recalculated; %d feature(s) corrected\n", corrected)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/feature-voting-platform/backend/migrations"
 	_ "github.com/lib/pq"
 	"github.com/rubenv/sql-migrate"
 )
@@ -34,21 +35,19 @@ func main() {
 		log.Fatalf("Failed to ping database: %v", err)
 	}
 
-	// Configure migrations
-	migrations := &migrate.FileMigrationSource{
-		Dir: "/migrations",
-	}
+	// Migrations are embedded in the binary, so no migrations directory has to be mounted
+	source := migrations.Source()
 
 	var n int
 	switch direction {
 	case "up":
-		n, err = migrate.Exec(db, "postgres", migrations, migrate.Up)
+		n, err = migrations.Up(db)
 		if err != nil {
 			log.Fatalf("Failed to apply migrations: %v", err)
 		}
 		fmt.Printf("Applied %d migrations\n", n)
 	case "down":
-		n, err = migrate.ExecMax(db, "postgres", migrations, migrate.Down, 1)
+		n, err = migrate.ExecMax(db, "postgres", source, migrate.Down, 1)
 		if err != nil {
 			log.Fatalf("Failed to rollback migration: %v", err)
 		}
@@ -0,0 +1,42 @@
+package activity
+
+import "time"
+
+// Event types recorded in the activity stream
+const (
+	TypeFeatureCreated = "feature_created"
+	TypeVoteMilestone  = "vote_milestone"
+)
+
+// VoteMilestones are the vote counts announced in the activity stream when a feature reaches them
+var VoteMilestones = []int{10, 25, 50, 100, 250, 500, 1000}
+
+// IsVoteMilestone reports whether reaching count votes is announced in the activity stream
+func IsVoteMilestone(count int) bool {
+	for _, milestone := range VoteMilestones {
+		if count == milestone {
+			return true
+		}
+	}
+	return false
+}
+
+// Event represents a single entry in the global activity stream
+type Event struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	ActorID       *int      `json:"actor_id,omitempty"`
+	ActorUsername *string   `json:"actor_username,omitempty"`
+	FeatureID     int       `json:"feature_id"`
+	FeatureTitle  string    `json:"feature_title"`
+	Milestone     *int      `json:"milestone,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// EventListResponse represents a paginated page of the activity stream
+type EventListResponse struct {
+	Events  []Event `json:"events"`
+	Total   int     `json:"total"`
+	Page    int     `json:"page"`
+	PerPage int     `json:"per_page"`
+}
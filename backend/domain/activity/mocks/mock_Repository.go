@@ -0,0 +1,147 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	activity "github.com/feature-voting-platform/backend/domain/activity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: page, perPage
+func (_m *MockRepository) List(page int, perPage int) ([]activity.Event, int, error) {
+	ret := _m.Called(page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []activity.Event
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]activity.Event, int, error)); ok {
+		return rf(page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []activity.Event); ok {
+		r0 = rf(page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activity.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(page, perPage)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, perPage)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - page int
+//   - perPage int
+func (_e *MockRepository_Expecter) List(page interface{}, perPage interface{}) *MockRepository_List_Call {
+	return &MockRepository_List_Call{Call: _e.mock.On("List", page, perPage)}
+}
+
+func (_c *MockRepository_List_Call) Run(run func(page int, perPage int)) *MockRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_List_Call) Return(_a0 []activity.Event, _a1 int, _a2 error) *MockRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_List_Call) RunAndReturn(run func(int, int) ([]activity.Event, int, error)) *MockRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: event
+func (_m *MockRepository) Record(event activity.Event) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(activity.Event) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - event activity.Event
+func (_e *MockRepository_Expecter) Record(event interface{}) *MockRepository_Record_Call {
+	return &MockRepository_Record_Call{Call: _e.mock.On("Record", event)}
+}
+
+func (_c *MockRepository_Record_Call) Run(run func(event activity.Event)) *MockRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(activity.Event))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Record_Call) Return(_a0 error) *MockRepository_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Record_Call) RunAndReturn(run func(activity.Event) error) *MockRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
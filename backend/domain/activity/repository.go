@@ -0,0 +1,7 @@
+package activity
+
+// Repository defines the interface for activity stream operations
+type Repository interface {
+	Record(event Event) error
+	List(page, perPage int) ([]Event, int, error)
+}
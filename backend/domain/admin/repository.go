@@ -0,0 +1,6 @@
+package admin
+
+// Repository defines the interface for read-only admin data operations
+type Repository interface {
+	GetStats() (*Stats, error)
+}
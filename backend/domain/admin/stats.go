@@ -0,0 +1,18 @@
+package admin
+
+// Stats summarizes the platform's data for consistency checks
+type Stats struct {
+	Features            int                 `json:"features"`
+	DeletedFeatures     int                 `json:"deleted_features"`
+	Votes               int                 `json:"votes"`
+	Users               int                 `json:"users"`
+	VoteCountMismatches []VoteCountMismatch `json:"vote_count_mismatches"`
+}
+
+// VoteCountMismatch is a feature whose stored vote count differs from the sum of its vote rows
+type VoteCountMismatch struct {
+	FeatureID   int    `json:"feature_id"`
+	Title       string `json:"title"`
+	StoredCount int    `json:"stored_count"`
+	ActualCount int    `json:"actual_count"`
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	audit "github.com/feature-voting-platform/backend/domain/audit"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with given fields: entry
+func (_m *MockRepository) Begin(entry *audit.Entry) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*audit.Entry) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type MockRepository_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - entry *audit.Entry
+func (_e *MockRepository_Expecter) Begin(entry interface{}) *MockRepository_Begin_Call {
+	return &MockRepository_Begin_Call{Call: _e.mock.On("Begin", entry)}
+}
+
+func (_c *MockRepository_Begin_Call) Run(run func(entry *audit.Entry)) *MockRepository_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*audit.Entry))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Begin_Call) Return(_a0 error) *MockRepository_Begin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Begin_Call) RunAndReturn(run func(*audit.Entry) error) *MockRepository_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function with given fields: id, actorID, status
+func (_m *MockRepository) Complete(id int, actorID *int, status int) error {
+	ret := _m.Called(id, actorID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, *int, int) error); ok {
+		r0 = rf(id, actorID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - id int
+//   - actorID *int
+//   - status int
+func (_e *MockRepository_Expecter) Complete(id interface{}, actorID interface{}, status interface{}) *MockRepository_Complete_Call {
+	return &MockRepository_Complete_Call{Call: _e.mock.On("Complete", id, actorID, status)}
+}
+
+func (_c *MockRepository_Complete_Call) Run(run func(id int, actorID *int, status int)) *MockRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(*int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Complete_Call) Return(_a0 error) *MockRepository_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Complete_Call) RunAndReturn(run func(int, *int, int) error) *MockRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
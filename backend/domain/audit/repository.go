@@ -0,0 +1,7 @@
+package audit
+
+// Repository defines the interface for audit log operations
+type Repository interface {
+	Begin(entry *Entry) error
+	Complete(id int, actorID *int, status int) error
+}
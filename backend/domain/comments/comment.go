@@ -0,0 +1,35 @@
+package comments
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxBodyLength is the maximum length of a comment body, in characters
+const MaxBodyLength = 2000
+
+// ErrBodyTooLong is returned when a comment body exceeds MaxBodyLength
+var ErrBodyTooLong = errors.New("comment body too long")
+
+// Comment represents a message in a feature's discussion thread
+type Comment struct {
+	ID        int       `json:"id"`
+	FeatureID int       `json:"feature_id"`
+	UserID    int       `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateCommentRequest represents the data needed to post a comment
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// ValidateBody checks the comment body against MaxBodyLength
+func ValidateBody(body string) error {
+	if utf8.RuneCountInString(body) > MaxBodyLength {
+		return ErrBodyTooLong
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	comments "github.com/feature-voting-platform/backend/domain/comments"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: comment
+func (_m *MockRepository) Create(comment *comments.Comment) error {
+	ret := _m.Called(comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*comments.Comment) error); ok {
+		r0 = rf(comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - comment *comments.Comment
+func (_e *MockRepository_Expecter) Create(comment interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", comment)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(comment *comments.Comment)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*comments.Comment))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(*comments.Comment) error) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MockRepository) Delete(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+func (_e *MockRepository_Expecter) Delete(id interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(id int)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(int) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByFeatureID provides a mock function with given fields: featureID
+func (_m *MockRepository) GetByFeatureID(featureID int) ([]comments.Comment, error) {
+	ret := _m.Called(featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByFeatureID")
+	}
+
+	var r0 []comments.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]comments.Comment, error)); ok {
+		return rf(featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []comments.Comment); ok {
+		r0 = rf(featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]comments.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetByFeatureID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByFeatureID'
+type MockRepository_GetByFeatureID_Call struct {
+	*mock.Call
+}
+
+// GetByFeatureID is a helper method to define mock.On call
+//   - featureID int
+func (_e *MockRepository_Expecter) GetByFeatureID(featureID interface{}) *MockRepository_GetByFeatureID_Call {
+	return &MockRepository_GetByFeatureID_Call{Call: _e.mock.On("GetByFeatureID", featureID)}
+}
+
+func (_c *MockRepository_GetByFeatureID_Call) Run(run func(featureID int)) *MockRepository_GetByFeatureID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByFeatureID_Call) Return(_a0 []comments.Comment, _a1 error) *MockRepository_GetByFeatureID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetByFeatureID_Call) RunAndReturn(run func(int) ([]comments.Comment, error)) *MockRepository_GetByFeatureID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,8 @@
+package comments
+
+// Repository defines the interface for comment data operations
+type Repository interface {
+	Create(comment *Comment) error
+	GetByFeatureID(featureID int) ([]Comment, error)
+	Delete(id int) error
+}
@@ -0,0 +1,12 @@
+package features
+
+import "time"
+
+// AttentionDigest lists open features that have aged without gathering votes, for moderators
+// to close, merge or promote
+type AttentionDigest struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	MinAgeDays  int       `json:"min_age_days"`
+	MaxVotes    int       `json:"max_votes"`
+	Features    []Feature `json:"features"`
+}
@@ -1,38 +1,217 @@
 package features
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"github.com/feature-voting-platform/backend/domain/users"
+)
+
+// Column limits enforced by the database; API limits may be lower but never higher
+const (
+	MaxTitleLength       = 255
+	MaxDescriptionLength = 5000
+)
+
+// Feature lifecycle statuses; new features start open
+const (
+	StatusOpen       = "open"
+	StatusPlanned    = "planned"
+	StatusInProgress = "in_progress"
+	StatusCompleted  = "completed"
+	StatusRejected   = "rejected"
+)
+
+// ValidStatus reports whether status is one of the known lifecycle statuses
+func ValidStatus(status string) bool {
+	switch status {
+	case StatusOpen, StatusPlanned, StatusInProgress, StatusCompleted, StatusRejected:
+		return true
+	}
+	return false
+}
+
+var (
+	ErrTitleTooLong       = errors.New("title too long")
+	ErrDescriptionTooLong = errors.New("description too long")
 )
 
 // Feature represents the core feature entity
 type Feature struct {
-	ID              int       `json:"id"`
-	Title           string    `json:"title"`
-	Description     string    `json:"description"`
-	CreatedBy       int       `json:"created_by"`
-	CreatedByUser   *string   `json:"created_by_user,omitempty"`
-	VoteCount       int       `json:"vote_count"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
-	HasUserVoted    bool      `json:"has_user_voted,omitempty"`
+	ID              int            `json:"id"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	CreatedBy       int            `json:"created_by"`
+	CreatedByUser   *string        `json:"created_by_user,omitempty"`
+	VoteCount       int            `json:"vote_count"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	HasUserVoted    bool           `json:"has_user_voted,omitempty"`
+	UserVote        int            `json:"user_vote,omitempty"`
+	VoteCountHidden bool           `json:"vote_count_hidden,omitempty"`
+	ExpiresAt       *time.Time     `json:"expires_at,omitempty"`
+	Expired         bool           `json:"expired"`
+	Status          string         `json:"status,omitempty"`
+	CategoryCounts  map[string]int `json:"category_counts,omitempty"`
+	IsNew           bool           `json:"is_new"`
+	Tags            []string       `json:"tags,omitempty"`
+}
+
+// MarkFreshness flags the feature as new when it was created less than window ago;
+// a zero window never flags anything
+func (f *Feature) MarkFreshness(window time.Duration, now time.Time) {
+	f.IsNew = window > 0 && now.Sub(f.CreatedAt) < window
+}
+
+// HideVoteCountFrom blanks the vote count unless the viewer is an admin, created the feature
+// or has voted on it
+func (f *Feature) HideVoteCountFrom(viewerID *int, viewerRole string) {
+	if viewerRole == users.RoleAdmin {
+		return
+	}
+	if viewerID != nil && (*viewerID == f.CreatedBy || f.HasUserVoted) {
+		return
+	}
+	f.VoteCount = 0
+	f.VoteCountHidden = true
 }
 
 // CreateFeatureRequest represents the data needed to create a feature
 type CreateFeatureRequest struct {
-	Title       string `json:"title" binding:"required,min=5,max=255"`
-	Description string `json:"description" binding:"required,min=10"`
+	Title       string     `json:"title" binding:"required,min=5"`
+	Description string     `json:"description" binding:"required,min=10"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
 }
 
 // UpdateFeatureRequest represents the data needed to update a feature
 type UpdateFeatureRequest struct {
-	Title       *string `json:"title,omitempty" binding:"omitempty,min=5,max=255"`
-	Description *string `json:"description,omitempty" binding:"omitempty,min=10"`
+	Title       *string    `json:"title,omitempty" binding:"omitempty,min=5"`
+	Description *string    `json:"description,omitempty" binding:"omitempty,min=10"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// UpdateStatusRequest represents the data needed to change a feature's lifecycle status
+type UpdateStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// ReplaceFeatureRequest represents the full feature resource sent on PUT
+type ReplaceFeatureRequest struct {
+	Title       string     `json:"title" binding:"required,min=5"`
+	Description string     `json:"description" binding:"required,min=10"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// ToUpdate converts a full replacement into an update touching every field
+func (r ReplaceFeatureRequest) ToUpdate() UpdateFeatureRequest {
+	return UpdateFeatureRequest{Title: &r.Title, Description: &r.Description, ExpiresAt: r.ExpiresAt}
 }
 
 // FeatureListResponse represents paginated feature list response
 type FeatureListResponse struct {
-	Features []Feature `json:"features"`
-	Total    int       `json:"total"`
-	Page     int       `json:"page"`
-	PerPage  int       `json:"per_page"`
-}
\ No newline at end of file
+	Features   []Feature `json:"features"`
+	Total      int       `json:"total"`
+	Page       int       `json:"page"`
+	PerPage    int       `json:"per_page"`
+	TotalPages int       `json:"total_pages"`
+	HasNext    bool      `json:"has_next"`
+	HasPrev    bool      `json:"has_prev"`
+}
+
+// NewFeatureListResponse builds a page of features with the page counts filled in; an
+// empty result has zero pages
+func NewFeatureListResponse(list []Feature, total, page, perPage int) FeatureListResponse {
+	totalPages := 0
+	if perPage > 0 {
+		totalPages = (total + perPage - 1) / perPage
+	}
+	return FeatureListResponse{
+		Features:   list,
+		Total:      total,
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
+// SortOrder selects how feature lists are ordered
+type SortOrder string
+
+const (
+	SortVotes  SortOrder = "votes"
+	SortNewest SortOrder = "newest"
+	SortOldest SortOrder = "oldest"
+)
+
+// ParseSortOrder validates a sort name
+func ParseSortOrder(s string) (SortOrder, bool) {
+	switch SortOrder(s) {
+	case SortVotes, SortNewest, SortOldest:
+		return SortOrder(s), true
+	}
+	return "", false
+}
+
+// ValidateLengths checks the title and description against the database column limits
+func ValidateLengths(title, description string) error {
+	if utf8.RuneCountInString(title) > MaxTitleLength {
+		return ErrTitleTooLong
+	}
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		return ErrDescriptionTooLong
+	}
+	return nil
+}
+
+// DescriptionRepeatsTitle reports whether the description is just the title again,
+// ignoring case, surrounding whitespace and trailing punctuation
+func DescriptionRepeatsTitle(title, description string) bool {
+	return normalizeText(title) == normalizeText(description)
+}
+
+// DescriptionQualityIssue returns guidance when a description has fewer than minWords
+// words or no sentence-ending punctuation, and "" when it is acceptable
+func DescriptionQualityIssue(description string, minWords int) string {
+	if words := len(strings.Fields(description)); words < minWords {
+		return fmt.Sprintf("Description should have at least %d words explaining what is needed and why", minWords)
+	}
+	if !strings.ContainsAny(description, ".!?") {
+		return "Description should contain at least one complete sentence ending in '.', '!' or '?'"
+	}
+	return ""
+}
+
+// EditDistance returns the number of single-character insertions, deletions and
+// substitutions needed to turn a into b
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func normalizeText(s string) string {
+	s = strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	return strings.TrimRight(s, ".!?")
+}
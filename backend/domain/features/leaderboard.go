@@ -0,0 +1,136 @@
+package features
+
+import (
+	"sort"
+	"time"
+)
+
+// LeaderboardWindow selects which votes count towards a leaderboard ranking
+type LeaderboardWindow string
+
+const (
+	WindowWeek  LeaderboardWindow = "week"
+	WindowMonth LeaderboardWindow = "month"
+	WindowAll   LeaderboardWindow = "all"
+)
+
+// ParseLeaderboardWindow validates a window name
+func ParseLeaderboardWindow(s string) (LeaderboardWindow, bool) {
+	switch LeaderboardWindow(s) {
+	case WindowWeek, WindowMonth, WindowAll:
+		return LeaderboardWindow(s), true
+	}
+	return "", false
+}
+
+// Since returns the start of the window relative to now, or nil for all-time
+func (w LeaderboardWindow) Since(now time.Time) *time.Time {
+	var since time.Time
+	switch w {
+	case WindowWeek:
+		since = now.AddDate(0, 0, -7)
+	case WindowMonth:
+		since = now.AddDate(0, -1, 0)
+	default:
+		return nil
+	}
+	return &since
+}
+
+// RankedFeature is a feature together with the votes it received inside a window
+type RankedFeature struct {
+	Feature
+	WindowVoteCount int `json:"window_vote_count"`
+}
+
+// HideVoteCountFrom blanks the total and windowed vote counts unless the viewer may see them
+func (f *RankedFeature) HideVoteCountFrom(viewerID *int, viewerRole string) {
+	f.Feature.HideVoteCountFrom(viewerID, viewerRole)
+	if f.VoteCountHidden {
+		f.WindowVoteCount = 0
+	}
+}
+
+// CoVotedFeature is a feature together with how many voters of another feature also voted for it
+type CoVotedFeature struct {
+	Feature
+	CoVoterCount int `json:"co_voter_count"`
+}
+
+// DefaultSurgeMultiplier is how many times its prior daily average a feature must be voted
+// in the last 24 hours to count as surging
+const DefaultSurgeMultiplier = 2.0
+
+// SurgingFeature is a feature whose votes in the last 24 hours outpace its prior daily average
+type SurgingFeature struct {
+	Feature
+	RecentVoteCount    int     `json:"recent_vote_count"`
+	BaselineDailyVotes float64 `json:"baseline_daily_votes"`
+}
+
+// HideVoteCountFrom blanks the total, recent and baseline vote counts unless the viewer may see them
+func (f *SurgingFeature) HideVoteCountFrom(viewerID *int, viewerRole string) {
+	f.Feature.HideVoteCountFrom(viewerID, viewerRole)
+	if f.VoteCountHidden {
+		f.RecentVoteCount = 0
+		f.BaselineDailyVotes = 0
+	}
+}
+
+// TeamPick is a feature together with the number of the viewer's teammates who voted for it
+type TeamPick struct {
+	Feature
+	TeammateVoteCount int `json:"teammate_vote_count"`
+}
+
+// VoteSnapshot is a feature's vote count as recorded on a given day
+type VoteSnapshot struct {
+	Date      time.Time
+	FeatureID int
+	VoteCount int
+}
+
+// RankPoint is a feature's rank among all features on a given day
+type RankPoint struct {
+	Date      time.Time `json:"date"`
+	VoteCount int       `json:"vote_count"`
+	Rank      int       `json:"rank"`
+}
+
+// RankHistory ranks featureID against every other feature snapshotted on the same day, oldest
+// day first. Ties share a rank (1, 2, 2, 4); days without a snapshot of the feature are skipped.
+func RankHistory(featureID int, snapshots []VoteSnapshot) []RankPoint {
+	byDay := make(map[time.Time][]VoteSnapshot)
+	var days []time.Time
+	for _, snapshot := range snapshots {
+		if _, seen := byDay[snapshot.Date]; !seen {
+			days = append(days, snapshot.Date)
+		}
+		byDay[snapshot.Date] = append(byDay[snapshot.Date], snapshot)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	history := []RankPoint{}
+	for _, day := range days {
+		var own *VoteSnapshot
+		for i, snapshot := range byDay[day] {
+			if snapshot.FeatureID == featureID {
+				own = &byDay[day][i]
+				break
+			}
+		}
+		if own == nil {
+			continue
+		}
+
+		rank := 1
+		for _, snapshot := range byDay[day] {
+			if snapshot.VoteCount > own.VoteCount {
+				rank++
+			}
+		}
+		history = append(history, RankPoint{Date: day, VoteCount: own.VoteCount, Rank: rank})
+	}
+
+	return history
+}
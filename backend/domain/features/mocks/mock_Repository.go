@@ -5,6 +5,8 @@ package mocks
 import (
 	features "github.com/feature-voting-platform/backend/domain/features"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockRepository is an autogenerated mock type for the Repository type
@@ -20,6 +22,165 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddTag provides a mock function with given fields: featureID, tag
+func (_m *MockRepository) AddTag(featureID int, tag string) error {
+	ret := _m.Called(featureID, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = rf(featureID, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type MockRepository_AddTag_Call struct {
+	*mock.Call
+}
+
+// AddTag is a helper method to define mock.On call
+//   - featureID int
+//   - tag string
+func (_e *MockRepository_Expecter) AddTag(featureID interface{}, tag interface{}) *MockRepository_AddTag_Call {
+	return &MockRepository_AddTag_Call{Call: _e.mock.On("AddTag", featureID, tag)}
+}
+
+func (_c *MockRepository_AddTag_Call) Run(run func(featureID int, tag string)) *MockRepository_AddTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_AddTag_Call) Return(_a0 error) *MockRepository_AddTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_AddTag_Call) RunAndReturn(run func(int, string) error) *MockRepository_AddTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByCreator provides a mock function with given fields: userID
+func (_m *MockRepository) CountByCreator(userID int) (int, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCreator")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountByCreator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCreator'
+type MockRepository_CountByCreator_Call struct {
+	*mock.Call
+}
+
+// CountByCreator is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) CountByCreator(userID interface{}) *MockRepository_CountByCreator_Call {
+	return &MockRepository_CountByCreator_Call{Call: _e.mock.On("CountByCreator", userID)}
+}
+
+func (_c *MockRepository_CountByCreator_Call) Run(run func(userID int)) *MockRepository_CountByCreator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountByCreator_Call) Return(_a0 int, _a1 error) *MockRepository_CountByCreator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountByCreator_Call) RunAndReturn(run func(int) (int, error)) *MockRepository_CountByCreator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountVotesReceived provides a mock function with given fields: userID
+func (_m *MockRepository) CountVotesReceived(userID int) (int, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountVotesReceived")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountVotesReceived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountVotesReceived'
+type MockRepository_CountVotesReceived_Call struct {
+	*mock.Call
+}
+
+// CountVotesReceived is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) CountVotesReceived(userID interface{}) *MockRepository_CountVotesReceived_Call {
+	return &MockRepository_CountVotesReceived_Call{Call: _e.mock.On("CountVotesReceived", userID)}
+}
+
+func (_c *MockRepository_CountVotesReceived_Call) Run(run func(userID int)) *MockRepository_CountVotesReceived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountVotesReceived_Call) Return(_a0 int, _a1 error) *MockRepository_CountVotesReceived_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountVotesReceived_Call) RunAndReturn(run func(int) (int, error)) *MockRepository_CountVotesReceived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: feature
 func (_m *MockRepository) Create(feature *features.Feature) error {
 	ret := _m.Called(feature)
@@ -112,6 +273,62 @@ func (_c *MockRepository_Delete_Call) RunAndReturn(run func(int) error) *MockRep
 	return _c
 }
 
+// ExpireFeatures provides a mock function with given fields: now
+func (_m *MockRepository) ExpireFeatures(now time.Time) (int, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireFeatures")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ExpireFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireFeatures'
+type MockRepository_ExpireFeatures_Call struct {
+	*mock.Call
+}
+
+// ExpireFeatures is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockRepository_Expecter) ExpireFeatures(now interface{}) *MockRepository_ExpireFeatures_Call {
+	return &MockRepository_ExpireFeatures_Call{Call: _e.mock.On("ExpireFeatures", now)}
+}
+
+func (_c *MockRepository_ExpireFeatures_Call) Run(run func(now time.Time)) *MockRepository_ExpireFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ExpireFeatures_Call) Return(_a0 int, _a1 error) *MockRepository_ExpireFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ExpireFeatures_Call) RunAndReturn(run func(time.Time) (int, error)) *MockRepository_ExpireFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FeatureExists provides a mock function with given fields: id
 func (_m *MockRepository) FeatureExists(id int) (bool, error) {
 	ret := _m.Called(id)
@@ -168,9 +385,9 @@ func (_c *MockRepository_FeatureExists_Call) RunAndReturn(run func(int) (bool, e
 	return _c
 }
 
-// GetAll provides a mock function with given fields: page, perPage, userID
-func (_m *MockRepository) GetAll(page int, perPage int, userID *int) ([]features.Feature, int, error) {
-	ret := _m.Called(page, perPage, userID)
+// GetAll provides a mock function with given fields: page, perPage, userID, sort, status, tag, createdAfter, createdBefore
+func (_m *MockRepository) GetAll(page int, perPage int, userID *int, sort features.SortOrder, status string, tag string, createdAfter *time.Time, createdBefore *time.Time) ([]features.Feature, int, error) {
+	ret := _m.Called(page, perPage, userID, sort, status, tag, createdAfter, createdBefore)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -179,25 +396,25 @@ func (_m *MockRepository) GetAll(page int, perPage int, userID *int) ([]features
 	var r0 []features.Feature
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(int, int, *int) ([]features.Feature, int, error)); ok {
-		return rf(page, perPage, userID)
+	if rf, ok := ret.Get(0).(func(int, int, *int, features.SortOrder, string, string, *time.Time, *time.Time) ([]features.Feature, int, error)); ok {
+		return rf(page, perPage, userID, sort, status, tag, createdAfter, createdBefore)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, *int) []features.Feature); ok {
-		r0 = rf(page, perPage, userID)
+	if rf, ok := ret.Get(0).(func(int, int, *int, features.SortOrder, string, string, *time.Time, *time.Time) []features.Feature); ok {
+		r0 = rf(page, perPage, userID, sort, status, tag, createdAfter, createdBefore)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]features.Feature)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, *int) int); ok {
-		r1 = rf(page, perPage, userID)
+	if rf, ok := ret.Get(1).(func(int, int, *int, features.SortOrder, string, string, *time.Time, *time.Time) int); ok {
+		r1 = rf(page, perPage, userID, sort, status, tag, createdAfter, createdBefore)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
-	if rf, ok := ret.Get(2).(func(int, int, *int) error); ok {
-		r2 = rf(page, perPage, userID)
+	if rf, ok := ret.Get(2).(func(int, int, *int, features.SortOrder, string, string, *time.Time, *time.Time) error); ok {
+		r2 = rf(page, perPage, userID, sort, status, tag, createdAfter, createdBefore)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -214,13 +431,18 @@ type MockRepository_GetAll_Call struct {
 //   - page int
 //   - perPage int
 //   - userID *int
-func (_e *MockRepository_Expecter) GetAll(page interface{}, perPage interface{}, userID interface{}) *MockRepository_GetAll_Call {
-	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", page, perPage, userID)}
+//   - sort features.SortOrder
+//   - status string
+//   - tag string
+//   - createdAfter *time.Time
+//   - createdBefore *time.Time
+func (_e *MockRepository_Expecter) GetAll(page interface{}, perPage interface{}, userID interface{}, sort interface{}, status interface{}, tag interface{}, createdAfter interface{}, createdBefore interface{}) *MockRepository_GetAll_Call {
+	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", page, perPage, userID, sort, status, tag, createdAfter, createdBefore)}
 }
 
-func (_c *MockRepository_GetAll_Call) Run(run func(page int, perPage int, userID *int)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_GetAll_Call) Run(run func(page int, perPage int, userID *int, sort features.SortOrder, status string, tag string, createdAfter *time.Time, createdBefore *time.Time)) *MockRepository_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(*int))
+		run(args[0].(int), args[1].(int), args[2].(*int), args[3].(features.SortOrder), args[4].(string), args[5].(string), args[6].(*time.Time), args[7].(*time.Time))
 	})
 	return _c
 }
@@ -230,7 +452,7 @@ func (_c *MockRepository_GetAll_Call) Return(_a0 []features.Feature, _a1 int, _a
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(int, int, *int) ([]features.Feature, int, error)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(int, int, *int, features.SortOrder, string, string, *time.Time, *time.Time) ([]features.Feature, int, error)) *MockRepository_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -352,50 +574,1078 @@ func (_c *MockRepository_GetByID_Call) RunAndReturn(run func(int, *int) (*featur
 	return _c
 }
 
-// Update provides a mock function with given fields: id, title, description
-func (_m *MockRepository) Update(id int, title *string, description *string) error {
-	ret := _m.Called(id, title, description)
+// GetByIDs provides a mock function with given fields: ids, userID
+func (_m *MockRepository) GetByIDs(ids []int, userID *int) ([]features.Feature, error) {
+	ret := _m.Called(ids, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetByIDs")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int, *string, *string) error); ok {
-		r0 = rf(id, title, description)
+	var r0 []features.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int, *int) ([]features.Feature, error)); ok {
+		return rf(ids, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]int, *int) []features.Feature); ok {
+		r0 = rf(ids, userID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.Feature)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func([]int, *int) error); ok {
+		r1 = rf(ids, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockRepository_Update_Call struct {
+// MockRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockRepository_GetByIDs_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - id int
-//   - title *string
-//   - description *string
-func (_e *MockRepository_Expecter) Update(id interface{}, title interface{}, description interface{}) *MockRepository_Update_Call {
-	return &MockRepository_Update_Call{Call: _e.mock.On("Update", id, title, description)}
+// GetByIDs is a helper method to define mock.On call
+//   - ids []int
+//   - userID *int
+func (_e *MockRepository_Expecter) GetByIDs(ids interface{}, userID interface{}) *MockRepository_GetByIDs_Call {
+	return &MockRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ids, userID)}
 }
 
-func (_c *MockRepository_Update_Call) Run(run func(id int, title *string, description *string)) *MockRepository_Update_Call {
+func (_c *MockRepository_GetByIDs_Call) Run(run func(ids []int, userID *int)) *MockRepository_GetByIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(*string), args[2].(*string))
+		run(args[0].([]int), args[1].(*int))
 	})
 	return _c
 }
 
-func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+func (_c *MockRepository_GetByIDs_Call) Return(_a0 []features.Feature, _a1 error) *MockRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetByIDs_Call) RunAndReturn(run func([]int, *int) ([]features.Feature, error)) *MockRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryCounts provides a mock function with given fields: featureID
+func (_m *MockRepository) GetCategoryCounts(featureID int) (map[string]int, error) {
+	ret := _m.Called(featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryCounts")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (map[string]int, error)); ok {
+		return rf(featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int) map[string]int); ok {
+		r0 = rf(featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetCategoryCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryCounts'
+type MockRepository_GetCategoryCounts_Call struct {
+	*mock.Call
+}
+
+// GetCategoryCounts is a helper method to define mock.On call
+//   - featureID int
+func (_e *MockRepository_Expecter) GetCategoryCounts(featureID interface{}) *MockRepository_GetCategoryCounts_Call {
+	return &MockRepository_GetCategoryCounts_Call{Call: _e.mock.On("GetCategoryCounts", featureID)}
+}
+
+func (_c *MockRepository_GetCategoryCounts_Call) Run(run func(featureID int)) *MockRepository_GetCategoryCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetCategoryCounts_Call) Return(_a0 map[string]int, _a1 error) *MockRepository_GetCategoryCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetCategoryCounts_Call) RunAndReturn(run func(int) (map[string]int, error)) *MockRepository_GetCategoryCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChangesSince provides a mock function with given fields: userID, since
+func (_m *MockRepository) GetChangesSince(userID int, since time.Time) (features.ChangeSummary, error) {
+	ret := _m.Called(userID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangesSince")
+	}
+
+	var r0 features.ChangeSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, time.Time) (features.ChangeSummary, error)); ok {
+		return rf(userID, since)
+	}
+	if rf, ok := ret.Get(0).(func(int, time.Time) features.ChangeSummary); ok {
+		r0 = rf(userID, since)
+	} else {
+		r0 = ret.Get(0).(features.ChangeSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, time.Time) error); ok {
+		r1 = rf(userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetChangesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangesSince'
+type MockRepository_GetChangesSince_Call struct {
+	*mock.Call
+}
+
+// GetChangesSince is a helper method to define mock.On call
+//   - userID int
+//   - since time.Time
+func (_e *MockRepository_Expecter) GetChangesSince(userID interface{}, since interface{}) *MockRepository_GetChangesSince_Call {
+	return &MockRepository_GetChangesSince_Call{Call: _e.mock.On("GetChangesSince", userID, since)}
+}
+
+func (_c *MockRepository_GetChangesSince_Call) Run(run func(userID int, since time.Time)) *MockRepository_GetChangesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetChangesSince_Call) Return(_a0 features.ChangeSummary, _a1 error) *MockRepository_GetChangesSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetChangesSince_Call) RunAndReturn(run func(int, time.Time) (features.ChangeSummary, error)) *MockRepository_GetChangesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCoVotedFeatures provides a mock function with given fields: featureID, limit
+func (_m *MockRepository) GetCoVotedFeatures(featureID int, limit int) ([]features.CoVotedFeature, error) {
+	ret := _m.Called(featureID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoVotedFeatures")
+	}
+
+	var r0 []features.CoVotedFeature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]features.CoVotedFeature, error)); ok {
+		return rf(featureID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []features.CoVotedFeature); ok {
+		r0 = rf(featureID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.CoVotedFeature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(featureID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetCoVotedFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCoVotedFeatures'
+type MockRepository_GetCoVotedFeatures_Call struct {
+	*mock.Call
+}
+
+// GetCoVotedFeatures is a helper method to define mock.On call
+//   - featureID int
+//   - limit int
+func (_e *MockRepository_Expecter) GetCoVotedFeatures(featureID interface{}, limit interface{}) *MockRepository_GetCoVotedFeatures_Call {
+	return &MockRepository_GetCoVotedFeatures_Call{Call: _e.mock.On("GetCoVotedFeatures", featureID, limit)}
+}
+
+func (_c *MockRepository_GetCoVotedFeatures_Call) Run(run func(featureID int, limit int)) *MockRepository_GetCoVotedFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetCoVotedFeatures_Call) Return(_a0 []features.CoVotedFeature, _a1 error) *MockRepository_GetCoVotedFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetCoVotedFeatures_Call) RunAndReturn(run func(int, int) ([]features.CoVotedFeature, error)) *MockRepository_GetCoVotedFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRankHistory provides a mock function with given fields: featureID, days
+func (_m *MockRepository) GetRankHistory(featureID int, days int) ([]features.RankPoint, error) {
+	ret := _m.Called(featureID, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRankHistory")
+	}
+
+	var r0 []features.RankPoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]features.RankPoint, error)); ok {
+		return rf(featureID, days)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []features.RankPoint); ok {
+		r0 = rf(featureID, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.RankPoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(featureID, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetRankHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRankHistory'
+type MockRepository_GetRankHistory_Call struct {
+	*mock.Call
+}
+
+// GetRankHistory is a helper method to define mock.On call
+//   - featureID int
+//   - days int
+func (_e *MockRepository_Expecter) GetRankHistory(featureID interface{}, days interface{}) *MockRepository_GetRankHistory_Call {
+	return &MockRepository_GetRankHistory_Call{Call: _e.mock.On("GetRankHistory", featureID, days)}
+}
+
+func (_c *MockRepository_GetRankHistory_Call) Run(run func(featureID int, days int)) *MockRepository_GetRankHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetRankHistory_Call) Return(_a0 []features.RankPoint, _a1 error) *MockRepository_GetRankHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetRankHistory_Call) RunAndReturn(run func(int, int) ([]features.RankPoint, error)) *MockRepository_GetRankHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaleFeatures provides a mock function with given fields: createdBefore, maxVotes
+func (_m *MockRepository) GetStaleFeatures(createdBefore time.Time, maxVotes int) ([]features.Feature, error) {
+	ret := _m.Called(createdBefore, maxVotes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaleFeatures")
+	}
+
+	var r0 []features.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]features.Feature, error)); ok {
+		return rf(createdBefore, maxVotes)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []features.Feature); ok {
+		r0 = rf(createdBefore, maxVotes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(createdBefore, maxVotes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetStaleFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaleFeatures'
+type MockRepository_GetStaleFeatures_Call struct {
+	*mock.Call
+}
+
+// GetStaleFeatures is a helper method to define mock.On call
+//   - createdBefore time.Time
+//   - maxVotes int
+func (_e *MockRepository_Expecter) GetStaleFeatures(createdBefore interface{}, maxVotes interface{}) *MockRepository_GetStaleFeatures_Call {
+	return &MockRepository_GetStaleFeatures_Call{Call: _e.mock.On("GetStaleFeatures", createdBefore, maxVotes)}
+}
+
+func (_c *MockRepository_GetStaleFeatures_Call) Run(run func(createdBefore time.Time, maxVotes int)) *MockRepository_GetStaleFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetStaleFeatures_Call) Return(_a0 []features.Feature, _a1 error) *MockRepository_GetStaleFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetStaleFeatures_Call) RunAndReturn(run func(time.Time, int) ([]features.Feature, error)) *MockRepository_GetStaleFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSurgingFeatures provides a mock function with given fields: multiplier, limit
+func (_m *MockRepository) GetSurgingFeatures(multiplier float64, limit int) ([]features.SurgingFeature, error) {
+	ret := _m.Called(multiplier, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSurgingFeatures")
+	}
+
+	var r0 []features.SurgingFeature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(float64, int) ([]features.SurgingFeature, error)); ok {
+		return rf(multiplier, limit)
+	}
+	if rf, ok := ret.Get(0).(func(float64, int) []features.SurgingFeature); ok {
+		r0 = rf(multiplier, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.SurgingFeature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(float64, int) error); ok {
+		r1 = rf(multiplier, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetSurgingFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSurgingFeatures'
+type MockRepository_GetSurgingFeatures_Call struct {
+	*mock.Call
+}
+
+// GetSurgingFeatures is a helper method to define mock.On call
+//   - multiplier float64
+//   - limit int
+func (_e *MockRepository_Expecter) GetSurgingFeatures(multiplier interface{}, limit interface{}) *MockRepository_GetSurgingFeatures_Call {
+	return &MockRepository_GetSurgingFeatures_Call{Call: _e.mock.On("GetSurgingFeatures", multiplier, limit)}
+}
+
+func (_c *MockRepository_GetSurgingFeatures_Call) Run(run func(multiplier float64, limit int)) *MockRepository_GetSurgingFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetSurgingFeatures_Call) Return(_a0 []features.SurgingFeature, _a1 error) *MockRepository_GetSurgingFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetSurgingFeatures_Call) RunAndReturn(run func(float64, int) ([]features.SurgingFeature, error)) *MockRepository_GetSurgingFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: featureID
+func (_m *MockRepository) GetTags(featureID int) ([]string, error) {
+	ret := _m.Called(featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]string, error)); ok {
+		return rf(featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []string); ok {
+		r0 = rf(featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockRepository_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - featureID int
+func (_e *MockRepository_Expecter) GetTags(featureID interface{}) *MockRepository_GetTags_Call {
+	return &MockRepository_GetTags_Call{Call: _e.mock.On("GetTags", featureID)}
+}
+
+func (_c *MockRepository_GetTags_Call) Run(run func(featureID int)) *MockRepository_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetTags_Call) Return(_a0 []string, _a1 error) *MockRepository_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetTags_Call) RunAndReturn(run func(int) ([]string, error)) *MockRepository_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamPicks provides a mock function with given fields: userID, limit
+func (_m *MockRepository) GetTeamPicks(userID int, limit int) ([]features.TeamPick, error) {
+	ret := _m.Called(userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamPicks")
+	}
+
+	var r0 []features.TeamPick
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]features.TeamPick, error)); ok {
+		return rf(userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []features.TeamPick); ok {
+		r0 = rf(userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.TeamPick)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetTeamPicks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamPicks'
+type MockRepository_GetTeamPicks_Call struct {
+	*mock.Call
+}
+
+// GetTeamPicks is a helper method to define mock.On call
+//   - userID int
+//   - limit int
+func (_e *MockRepository_Expecter) GetTeamPicks(userID interface{}, limit interface{}) *MockRepository_GetTeamPicks_Call {
+	return &MockRepository_GetTeamPicks_Call{Call: _e.mock.On("GetTeamPicks", userID, limit)}
+}
+
+func (_c *MockRepository_GetTeamPicks_Call) Run(run func(userID int, limit int)) *MockRepository_GetTeamPicks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetTeamPicks_Call) Return(_a0 []features.TeamPick, _a1 error) *MockRepository_GetTeamPicks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetTeamPicks_Call) RunAndReturn(run func(int, int) ([]features.TeamPick, error)) *MockRepository_GetTeamPicks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTop provides a mock function with given fields: since, limit
+func (_m *MockRepository) GetTop(since *time.Time, limit int) ([]features.RankedFeature, error) {
+	ret := _m.Called(since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTop")
+	}
+
+	var r0 []features.RankedFeature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*time.Time, int) ([]features.RankedFeature, error)); ok {
+		return rf(since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*time.Time, int) []features.RankedFeature); ok {
+		r0 = rf(since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.RankedFeature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*time.Time, int) error); ok {
+		r1 = rf(since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetTop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTop'
+type MockRepository_GetTop_Call struct {
+	*mock.Call
+}
+
+// GetTop is a helper method to define mock.On call
+//   - since *time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) GetTop(since interface{}, limit interface{}) *MockRepository_GetTop_Call {
+	return &MockRepository_GetTop_Call{Call: _e.mock.On("GetTop", since, limit)}
+}
+
+func (_c *MockRepository_GetTop_Call) Run(run func(since *time.Time, limit int)) *MockRepository_GetTop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*time.Time), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetTop_Call) Return(_a0 []features.RankedFeature, _a1 error) *MockRepository_GetTop_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetTop_Call) RunAndReturn(run func(*time.Time, int) ([]features.RankedFeature, error)) *MockRepository_GetTop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserStats provides a mock function with given fields: userID
+func (_m *MockRepository) GetUserStats(userID int) (features.UserStats, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStats")
+	}
+
+	var r0 features.UserStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (features.UserStats, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) features.UserStats); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(features.UserStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetUserStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserStats'
+type MockRepository_GetUserStats_Call struct {
+	*mock.Call
+}
+
+// GetUserStats is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) GetUserStats(userID interface{}) *MockRepository_GetUserStats_Call {
+	return &MockRepository_GetUserStats_Call{Call: _e.mock.On("GetUserStats", userID)}
+}
+
+func (_c *MockRepository_GetUserStats_Call) Run(run func(userID int)) *MockRepository_GetUserStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetUserStats_Call) Return(_a0 features.UserStats, _a1 error) *MockRepository_GetUserStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetUserStats_Call) RunAndReturn(run func(int) (features.UserStats, error)) *MockRepository_GetUserStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVotable provides a mock function with given fields: userID, page, perPage
+func (_m *MockRepository) GetVotable(userID int, page int, perPage int) ([]features.Feature, int, error) {
+	ret := _m.Called(userID, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVotable")
+	}
+
+	var r0 []features.Feature
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, int) ([]features.Feature, int, error)); ok {
+		return rf(userID, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) []features.Feature); ok {
+		r0 = rf(userID, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) int); ok {
+		r1 = rf(userID, page, perPage)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, int) error); ok {
+		r2 = rf(userID, page, perPage)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_GetVotable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVotable'
+type MockRepository_GetVotable_Call struct {
+	*mock.Call
+}
+
+// GetVotable is a helper method to define mock.On call
+//   - userID int
+//   - page int
+//   - perPage int
+func (_e *MockRepository_Expecter) GetVotable(userID interface{}, page interface{}, perPage interface{}) *MockRepository_GetVotable_Call {
+	return &MockRepository_GetVotable_Call{Call: _e.mock.On("GetVotable", userID, page, perPage)}
+}
+
+func (_c *MockRepository_GetVotable_Call) Run(run func(userID int, page int, perPage int)) *MockRepository_GetVotable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVotable_Call) Return(_a0 []features.Feature, _a1 int, _a2 error) *MockRepository_GetVotable_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_GetVotable_Call) RunAndReturn(run func(int, int, int) ([]features.Feature, int, error)) *MockRepository_GetVotable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecalculateVoteCounts provides a mock function with no fields
+func (_m *MockRepository) RecalculateVoteCounts() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecalculateVoteCounts")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_RecalculateVoteCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecalculateVoteCounts'
+type MockRepository_RecalculateVoteCounts_Call struct {
+	*mock.Call
+}
+
+// RecalculateVoteCounts is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) RecalculateVoteCounts() *MockRepository_RecalculateVoteCounts_Call {
+	return &MockRepository_RecalculateVoteCounts_Call{Call: _e.mock.On("RecalculateVoteCounts")}
+}
+
+func (_c *MockRepository_RecalculateVoteCounts_Call) Run(run func()) *MockRepository_RecalculateVoteCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_RecalculateVoteCounts_Call) Return(_a0 int, _a1 error) *MockRepository_RecalculateVoteCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_RecalculateVoteCounts_Call) RunAndReturn(run func() (int, error)) *MockRepository_RecalculateVoteCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTag provides a mock function with given fields: featureID, tag
+func (_m *MockRepository) RemoveTag(featureID int, tag string) error {
+	ret := _m.Called(featureID, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = rf(featureID, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemoveTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTag'
+type MockRepository_RemoveTag_Call struct {
+	*mock.Call
+}
+
+// RemoveTag is a helper method to define mock.On call
+//   - featureID int
+//   - tag string
+func (_e *MockRepository_Expecter) RemoveTag(featureID interface{}, tag interface{}) *MockRepository_RemoveTag_Call {
+	return &MockRepository_RemoveTag_Call{Call: _e.mock.On("RemoveTag", featureID, tag)}
+}
+
+func (_c *MockRepository_RemoveTag_Call) Run(run func(featureID int, tag string)) *MockRepository_RemoveTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveTag_Call) Return(_a0 error) *MockRepository_RemoveTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemoveTag_Call) RunAndReturn(run func(int, string) error) *MockRepository_RemoveTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: id
+func (_m *MockRepository) Restore(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id int
+func (_e *MockRepository_Expecter) Restore(id interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockRepository_Restore_Call) Run(run func(id int)) *MockRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) Return(_a0 error) *MockRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(int) error) *MockRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchFeatures provides a mock function with given fields: query, page, perPage, userID
+func (_m *MockRepository) SearchFeatures(query string, page int, perPage int, userID *int) ([]features.Feature, int, error) {
+	ret := _m.Called(query, page, perPage, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchFeatures")
+	}
+
+	var r0 []features.Feature
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int, *int) ([]features.Feature, int, error)); ok {
+		return rf(query, page, perPage, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, *int) []features.Feature); ok {
+		r0 = rf(query, page, perPage, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, *int) int); ok {
+		r1 = rf(query, page, perPage, userID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int, *int) error); ok {
+		r2 = rf(query, page, perPage, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_SearchFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchFeatures'
+type MockRepository_SearchFeatures_Call struct {
+	*mock.Call
+}
+
+// SearchFeatures is a helper method to define mock.On call
+//   - query string
+//   - page int
+//   - perPage int
+//   - userID *int
+func (_e *MockRepository_Expecter) SearchFeatures(query interface{}, page interface{}, perPage interface{}, userID interface{}) *MockRepository_SearchFeatures_Call {
+	return &MockRepository_SearchFeatures_Call{Call: _e.mock.On("SearchFeatures", query, page, perPage, userID)}
+}
+
+func (_c *MockRepository_SearchFeatures_Call) Run(run func(query string, page int, perPage int, userID *int)) *MockRepository_SearchFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int), args[3].(*int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SearchFeatures_Call) Return(_a0 []features.Feature, _a1 int, _a2 error) *MockRepository_SearchFeatures_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_SearchFeatures_Call) RunAndReturn(run func(string, int, int, *int) ([]features.Feature, int, error)) *MockRepository_SearchFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnapshotVoteCounts provides a mock function with no fields
+func (_m *MockRepository) SnapshotVoteCounts() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnapshotVoteCounts")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_SnapshotVoteCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnapshotVoteCounts'
+type MockRepository_SnapshotVoteCounts_Call struct {
+	*mock.Call
+}
+
+// SnapshotVoteCounts is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) SnapshotVoteCounts() *MockRepository_SnapshotVoteCounts_Call {
+	return &MockRepository_SnapshotVoteCounts_Call{Call: _e.mock.On("SnapshotVoteCounts")}
+}
+
+func (_c *MockRepository_SnapshotVoteCounts_Call) Run(run func()) *MockRepository_SnapshotVoteCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_SnapshotVoteCounts_Call) Return(_a0 int, _a1 error) *MockRepository_SnapshotVoteCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_SnapshotVoteCounts_Call) RunAndReturn(run func() (int, error)) *MockRepository_SnapshotVoteCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: id, title, description, expiresAt
+func (_m *MockRepository) Update(id int, title *string, description *string, expiresAt *time.Time) error {
+	ret := _m.Called(id, title, description, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, *string, *string, *time.Time) error); ok {
+		r0 = rf(id, title, description, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - id int
+//   - title *string
+//   - description *string
+//   - expiresAt *time.Time
+func (_e *MockRepository_Expecter) Update(id interface{}, title interface{}, description interface{}, expiresAt interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", id, title, description, expiresAt)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(id int, title *string, description *string, expiresAt *time.Time)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(*string), args[2].(*string), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(int, *string, *string, *time.Time) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: id, status
+func (_m *MockRepository) UpdateStatus(id int, status string) error {
+	ret := _m.Called(id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = rf(id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - id int
+//   - status string
+func (_e *MockRepository_Expecter) UpdateStatus(id interface{}, status interface{}) *MockRepository_UpdateStatus_Call {
+	return &MockRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", id, status)}
+}
+
+func (_c *MockRepository_UpdateStatus_Call) Run(run func(id int, status string)) *MockRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateStatus_Call) Return(_a0 error) *MockRepository_UpdateStatus_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_Update_Call) RunAndReturn(run func(int, *string, *string) error) *MockRepository_Update_Call {
+func (_c *MockRepository_UpdateStatus_Call) RunAndReturn(run func(int, string) error) *MockRepository_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,12 +1,36 @@
 package features
 
+import "time"
+
 // Repository defines the interface for feature data operations
 type Repository interface {
 	Create(feature *Feature) error
 	GetByID(id int, userID *int) (*Feature, error)
-	GetAll(page, perPage int, userID *int) ([]Feature, int, error)
+	GetByIDs(ids []int, userID *int) ([]Feature, error)
+	GetAll(page, perPage int, userID *int, sort SortOrder, status, tag string, createdAfter, createdBefore *time.Time) ([]Feature, int, error)
+	SearchFeatures(query string, page, perPage int, userID *int) ([]Feature, int, error)
 	GetByCreatedBy(userID int) ([]Feature, error)
-	Update(id int, title, description *string) error
+	GetVotable(userID, page, perPage int) ([]Feature, int, error)
+	GetTop(since *time.Time, limit int) ([]RankedFeature, error)
+	GetTeamPicks(userID, limit int) ([]TeamPick, error)
+	GetCoVotedFeatures(featureID int, limit int) ([]CoVotedFeature, error)
+	GetCategoryCounts(featureID int) (map[string]int, error)
+	GetSurgingFeatures(multiplier float64, limit int) ([]SurgingFeature, error)
+	Update(id int, title, description *string, expiresAt *time.Time) error
+	UpdateStatus(id int, status string) error
 	Delete(id int) error
+	Restore(id int) error
+	AddTag(featureID int, tag string) error
+	RemoveTag(featureID int, tag string) error
+	GetTags(featureID int) ([]string, error)
 	FeatureExists(id int) (bool, error)
+	CountByCreator(userID int) (int, error)
+	CountVotesReceived(userID int) (int, error)
+	GetUserStats(userID int) (UserStats, error)
+	GetChangesSince(userID int, since time.Time) (ChangeSummary, error)
+	ExpireFeatures(now time.Time) (int, error)
+	SnapshotVoteCounts() (int, error)
+	RecalculateVoteCounts() (int, error)
+	GetRankHistory(featureID int, days int) ([]RankPoint, error)
+	GetStaleFeatures(createdBefore time.Time, maxVotes int) ([]Feature, error)
 }
\ No newline at end of file
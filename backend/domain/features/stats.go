@@ -0,0 +1,20 @@
+package features
+
+import "time"
+
+// UserStats aggregates the features created by a single user
+type UserStats struct {
+	UserID                 int     `json:"user_id"`
+	FeatureCount           int     `json:"feature_count"`
+	TotalVotesReceived     int     `json:"total_votes_received"`
+	AverageVotesPerFeature float64 `json:"average_votes_per_feature"`
+	MostVotedFeatureTitle  *string `json:"most_voted_feature_title"`
+}
+
+// ChangeSummary counts what happened since a user's previous login
+type ChangeSummary struct {
+	Since                *time.Time `json:"since"`
+	FirstLogin           bool       `json:"first_login"`
+	NewFeatures          int        `json:"new_features"`
+	NewVotesOnMyFeatures int        `json:"new_votes_on_my_features"`
+}
@@ -0,0 +1,52 @@
+package features
+
+import (
+	"errors"
+	"strings"
+)
+
+// Tag limits; tags appear in URLs, so they are restricted to a URL-safe alphabet
+const (
+	MaxTagLength      = 30
+	MaxTagsPerFeature = 10
+)
+
+// ErrInvalidTag is returned for tags that are empty, too long or use characters other than
+// lowercase letters, digits, '-' and '_'
+var ErrInvalidTag = errors.New("invalid tag")
+
+// NormalizeTag trims and lower-cases a tag and checks it is valid
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || len(tag) > MaxTagLength {
+		return "", ErrInvalidTag
+	}
+	for _, r := range tag {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return "", ErrInvalidTag
+		}
+	}
+	return tag, nil
+}
+
+// NormalizeTags normalizes every tag and drops duplicates, keeping the first occurrence's order
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized, nil
+}
+
+// AddTagsRequest represents the tags to add to a feature
+type AddTagsRequest struct {
+	Tags []string `json:"tags" binding:"required,min=1,max=10"`
+}
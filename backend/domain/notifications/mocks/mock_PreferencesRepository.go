@@ -0,0 +1,139 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	notifications "github.com/feature-voting-platform/backend/domain/notifications"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPreferencesRepository is an autogenerated mock type for the PreferencesRepository type
+type MockPreferencesRepository struct {
+	mock.Mock
+}
+
+type MockPreferencesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPreferencesRepository) EXPECT() *MockPreferencesRepository_Expecter {
+	return &MockPreferencesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPreferences provides a mock function with given fields: userID
+func (_m *MockPreferencesRepository) GetPreferences(userID int) (*notifications.Preferences, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 *notifications.Preferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*notifications.Preferences, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *notifications.Preferences); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*notifications.Preferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPreferencesRepository_GetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferences'
+type MockPreferencesRepository_GetPreferences_Call struct {
+	*mock.Call
+}
+
+// GetPreferences is a helper method to define mock.On call
+//   - userID int
+func (_e *MockPreferencesRepository_Expecter) GetPreferences(userID interface{}) *MockPreferencesRepository_GetPreferences_Call {
+	return &MockPreferencesRepository_GetPreferences_Call{Call: _e.mock.On("GetPreferences", userID)}
+}
+
+func (_c *MockPreferencesRepository_GetPreferences_Call) Run(run func(userID int)) *MockPreferencesRepository_GetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockPreferencesRepository_GetPreferences_Call) Return(_a0 *notifications.Preferences, _a1 error) *MockPreferencesRepository_GetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPreferencesRepository_GetPreferences_Call) RunAndReturn(run func(int) (*notifications.Preferences, error)) *MockPreferencesRepository_GetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertPreferences provides a mock function with given fields: prefs
+func (_m *MockPreferencesRepository) UpsertPreferences(prefs *notifications.Preferences) error {
+	ret := _m.Called(prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*notifications.Preferences) error); ok {
+		r0 = rf(prefs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPreferencesRepository_UpsertPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPreferences'
+type MockPreferencesRepository_UpsertPreferences_Call struct {
+	*mock.Call
+}
+
+// UpsertPreferences is a helper method to define mock.On call
+//   - prefs *notifications.Preferences
+func (_e *MockPreferencesRepository_Expecter) UpsertPreferences(prefs interface{}) *MockPreferencesRepository_UpsertPreferences_Call {
+	return &MockPreferencesRepository_UpsertPreferences_Call{Call: _e.mock.On("UpsertPreferences", prefs)}
+}
+
+func (_c *MockPreferencesRepository_UpsertPreferences_Call) Run(run func(prefs *notifications.Preferences)) *MockPreferencesRepository_UpsertPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*notifications.Preferences))
+	})
+	return _c
+}
+
+func (_c *MockPreferencesRepository_UpsertPreferences_Call) Return(_a0 error) *MockPreferencesRepository_UpsertPreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPreferencesRepository_UpsertPreferences_Call) RunAndReturn(run func(*notifications.Preferences) error) *MockPreferencesRepository_UpsertPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPreferencesRepository creates a new instance of MockPreferencesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPreferencesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPreferencesRepository {
+	mock := &MockPreferencesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,44 @@
+package notifications
+
+import (
+	"time"
+)
+
+// Preferences holds a user's per-event notification toggles
+type Preferences struct {
+	UserID              int        `json:"user_id"`
+	VoteOnMyFeature     bool       `json:"vote_on_my_feature"`
+	StatusChange        bool       `json:"status_change"`
+	SubscriptionUpdates bool       `json:"subscription_updates"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// DefaultPreferences returns the preferences used when a user has not stored any
+func DefaultPreferences(userID int) *Preferences {
+	return &Preferences{
+		UserID:              userID,
+		VoteOnMyFeature:     true,
+		StatusChange:        true,
+		SubscriptionUpdates: true,
+	}
+}
+
+// UpdatePreferencesRequest represents a partial update of notification preferences
+type UpdatePreferencesRequest struct {
+	VoteOnMyFeature     *bool `json:"vote_on_my_feature,omitempty"`
+	StatusChange        *bool `json:"status_change,omitempty"`
+	SubscriptionUpdates *bool `json:"subscription_updates,omitempty"`
+}
+
+// Apply copies the fields set in the request onto the preferences
+func (r UpdatePreferencesRequest) Apply(p *Preferences) {
+	if r.VoteOnMyFeature != nil {
+		p.VoteOnMyFeature = *r.VoteOnMyFeature
+	}
+	if r.StatusChange != nil {
+		p.StatusChange = *r.StatusChange
+	}
+	if r.SubscriptionUpdates != nil {
+		p.SubscriptionUpdates = *r.SubscriptionUpdates
+	}
+}
@@ -0,0 +1,7 @@
+package notifications
+
+// PreferencesRepository defines the interface for notification preference data operations
+type PreferencesRepository interface {
+	GetPreferences(userID int) (*Preferences, error)
+	UpsertPreferences(prefs *Preferences) error
+}
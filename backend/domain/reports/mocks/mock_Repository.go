@@ -0,0 +1,210 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	reports "github.com/feature-voting-platform/backend/domain/reports"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: featureID, reporterID, reason
+func (_m *MockRepository) Create(featureID int, reporterID int, reason string) (*reports.Report, error) {
+	ret := _m.Called(featureID, reporterID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *reports.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string) (*reports.Report, error)); ok {
+		return rf(featureID, reporterID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) *reports.Report); ok {
+		r0 = rf(featureID, reporterID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reports.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) error); ok {
+		r1 = rf(featureID, reporterID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - featureID int
+//   - reporterID int
+//   - reason string
+func (_e *MockRepository_Expecter) Create(featureID interface{}, reporterID interface{}, reason interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", featureID, reporterID, reason)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(featureID int, reporterID int, reason string)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 *reports.Report, _a1 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(int, int, string) (*reports.Report, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with no fields
+func (_m *MockRepository) GetAll() ([]reports.Report, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []reports.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]reports.Report, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []reports.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reports.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) GetAll() *MockRepository_GetAll_Call {
+	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll")}
+}
+
+func (_c *MockRepository_GetAll_Call) Run(run func()) *MockRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAll_Call) Return(_a0 []reports.Report, _a1 error) *MockRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetAll_Call) RunAndReturn(run func() ([]reports.Report, error)) *MockRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByFeature provides a mock function with given fields: featureID
+func (_m *MockRepository) GetByFeature(featureID int) ([]reports.Report, error) {
+	ret := _m.Called(featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByFeature")
+	}
+
+	var r0 []reports.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]reports.Report, error)); ok {
+		return rf(featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []reports.Report); ok {
+		r0 = rf(featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reports.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetByFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByFeature'
+type MockRepository_GetByFeature_Call struct {
+	*mock.Call
+}
+
+// GetByFeature is a helper method to define mock.On call
+//   - featureID int
+func (_e *MockRepository_Expecter) GetByFeature(featureID interface{}) *MockRepository_GetByFeature_Call {
+	return &MockRepository_GetByFeature_Call{Call: _e.mock.On("GetByFeature", featureID)}
+}
+
+func (_c *MockRepository_GetByFeature_Call) Run(run func(featureID int)) *MockRepository_GetByFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByFeature_Call) Return(_a0 []reports.Report, _a1 error) *MockRepository_GetByFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetByFeature_Call) RunAndReturn(run func(int) ([]reports.Report, error)) *MockRepository_GetByFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,39 @@
+package reports
+
+import "time"
+
+// Reasons a feature can be reported for
+const (
+	ReasonSpam          = "spam"
+	ReasonAbusive       = "abusive"
+	ReasonDuplicate     = "duplicate"
+	ReasonInappropriate = "inappropriate"
+	ReasonOther         = "other"
+)
+
+// Reasons lists the valid report reasons
+var Reasons = []string{ReasonSpam, ReasonAbusive, ReasonDuplicate, ReasonInappropriate, ReasonOther}
+
+// ValidReason reports whether reason is one of Reasons
+func ValidReason(reason string) bool {
+	for _, r := range Reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// Report represents a user flagging a feature for moderation
+type Report struct {
+	ID         int       `json:"id"`
+	FeatureID  int       `json:"feature_id"`
+	ReporterID int       `json:"reporter_id"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// CreateReportRequest represents the data needed to report a feature
+type CreateReportRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
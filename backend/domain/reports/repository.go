@@ -0,0 +1,8 @@
+package reports
+
+// Repository defines the interface for feature report data operations
+type Repository interface {
+	Create(featureID, reporterID int, reason string) (*Report, error)
+	GetByFeature(featureID int) ([]Report, error)
+	GetAll() ([]Report, error)
+}
@@ -0,0 +1,196 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	features "github.com/feature-voting-platform/backend/domain/features"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetSubscribedFeatures provides a mock function with given fields: userID, page, perPage
+func (_m *MockRepository) GetSubscribedFeatures(userID int, page int, perPage int) ([]features.Feature, int, error) {
+	ret := _m.Called(userID, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscribedFeatures")
+	}
+
+	var r0 []features.Feature
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, int) ([]features.Feature, int, error)); ok {
+		return rf(userID, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) []features.Feature); ok {
+		r0 = rf(userID, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]features.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) int); ok {
+		r1 = rf(userID, page, perPage)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, int) error); ok {
+		r2 = rf(userID, page, perPage)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_GetSubscribedFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscribedFeatures'
+type MockRepository_GetSubscribedFeatures_Call struct {
+	*mock.Call
+}
+
+// GetSubscribedFeatures is a helper method to define mock.On call
+//   - userID int
+//   - page int
+//   - perPage int
+func (_e *MockRepository_Expecter) GetSubscribedFeatures(userID interface{}, page interface{}, perPage interface{}) *MockRepository_GetSubscribedFeatures_Call {
+	return &MockRepository_GetSubscribedFeatures_Call{Call: _e.mock.On("GetSubscribedFeatures", userID, page, perPage)}
+}
+
+func (_c *MockRepository_GetSubscribedFeatures_Call) Run(run func(userID int, page int, perPage int)) *MockRepository_GetSubscribedFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetSubscribedFeatures_Call) Return(_a0 []features.Feature, _a1 int, _a2 error) *MockRepository_GetSubscribedFeatures_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_GetSubscribedFeatures_Call) RunAndReturn(run func(int, int, int) ([]features.Feature, int, error)) *MockRepository_GetSubscribedFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: userID, featureID
+func (_m *MockRepository) Subscribe(userID int, featureID int) error {
+	ret := _m.Called(userID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = rf(userID, featureID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockRepository_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - userID int
+//   - featureID int
+func (_e *MockRepository_Expecter) Subscribe(userID interface{}, featureID interface{}) *MockRepository_Subscribe_Call {
+	return &MockRepository_Subscribe_Call{Call: _e.mock.On("Subscribe", userID, featureID)}
+}
+
+func (_c *MockRepository_Subscribe_Call) Run(run func(userID int, featureID int)) *MockRepository_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Subscribe_Call) Return(_a0 error) *MockRepository_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Subscribe_Call) RunAndReturn(run func(int, int) error) *MockRepository_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: userID, featureID
+func (_m *MockRepository) Unsubscribe(userID int, featureID int) error {
+	ret := _m.Called(userID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = rf(userID, featureID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type MockRepository_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - userID int
+//   - featureID int
+func (_e *MockRepository_Expecter) Unsubscribe(userID interface{}, featureID interface{}) *MockRepository_Unsubscribe_Call {
+	return &MockRepository_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", userID, featureID)}
+}
+
+func (_c *MockRepository_Unsubscribe_Call) Run(run func(userID int, featureID int)) *MockRepository_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Unsubscribe_Call) Return(_a0 error) *MockRepository_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Unsubscribe_Call) RunAndReturn(run func(int, int) error) *MockRepository_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
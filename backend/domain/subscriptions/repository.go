@@ -0,0 +1,10 @@
+package subscriptions
+
+import "github.com/feature-voting-platform/backend/domain/features"
+
+// Repository defines the interface for subscription data operations
+type Repository interface {
+	Subscribe(userID, featureID int) error
+	Unsubscribe(userID, featureID int) error
+	GetSubscribedFeatures(userID, page, perPage int) ([]features.Feature, int, error)
+}
@@ -0,0 +1,13 @@
+package subscriptions
+
+import (
+	"time"
+)
+
+// Subscription represents a user following updates on a feature
+type Subscription struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	FeatureID int       `json:"feature_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	users "github.com/feature-voting-platform/backend/domain/users"
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
+
+	users "github.com/feature-voting-platform/backend/domain/users"
 )
 
 // MockRepository is an autogenerated mock type for the Repository type
@@ -112,6 +115,119 @@ func (_c *MockRepository_Delete_Call) RunAndReturn(run func(int) error) *MockRep
 	return _c
 }
 
+// EmailExists provides a mock function with given fields: email
+func (_m *MockRepository) EmailExists(email string) (bool, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmailExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_EmailExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailExists'
+type MockRepository_EmailExists_Call struct {
+	*mock.Call
+}
+
+// EmailExists is a helper method to define mock.On call
+//   - email string
+func (_e *MockRepository_Expecter) EmailExists(email interface{}) *MockRepository_EmailExists_Call {
+	return &MockRepository_EmailExists_Call{Call: _e.mock.On("EmailExists", email)}
+}
+
+func (_c *MockRepository_EmailExists_Call) Run(run func(email string)) *MockRepository_EmailExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_EmailExists_Call) Return(_a0 bool, _a1 error) *MockRepository_EmailExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_EmailExists_Call) RunAndReturn(run func(string) (bool, error)) *MockRepository_EmailExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with no fields
+func (_m *MockRepository) GetAll() ([]users.User, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []users.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]users.User, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []users.User); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]users.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) GetAll() *MockRepository_GetAll_Call {
+	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll")}
+}
+
+func (_c *MockRepository_GetAll_Call) Run(run func()) *MockRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAll_Call) Return(_a0 []users.User, _a1 error) *MockRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetAll_Call) RunAndReturn(run func() ([]users.User, error)) *MockRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEmail provides a mock function with given fields: email
 func (_m *MockRepository) GetByEmail(email string) (*users.User, error) {
 	ret := _m.Called(email)
@@ -286,6 +402,110 @@ func (_c *MockRepository_GetByUsername_Call) RunAndReturn(run func(string) (*use
 	return _c
 }
 
+// GetPreviousLoginAt provides a mock function with given fields: id
+func (_m *MockRepository) GetPreviousLoginAt(id int) (*time.Time, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviousLoginAt")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*time.Time, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *time.Time); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPreviousLoginAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousLoginAt'
+type MockRepository_GetPreviousLoginAt_Call struct {
+	*mock.Call
+}
+
+// GetPreviousLoginAt is a helper method to define mock.On call
+//   - id int
+func (_e *MockRepository_Expecter) GetPreviousLoginAt(id interface{}) *MockRepository_GetPreviousLoginAt_Call {
+	return &MockRepository_GetPreviousLoginAt_Call{Call: _e.mock.On("GetPreviousLoginAt", id)}
+}
+
+func (_c *MockRepository_GetPreviousLoginAt_Call) Run(run func(id int)) *MockRepository_GetPreviousLoginAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPreviousLoginAt_Call) Return(_a0 *time.Time, _a1 error) *MockRepository_GetPreviousLoginAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPreviousLoginAt_Call) RunAndReturn(run func(int) (*time.Time, error)) *MockRepository_GetPreviousLoginAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordLogin provides a mock function with given fields: id
+func (_m *MockRepository) RecordLogin(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordLogin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RecordLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordLogin'
+type MockRepository_RecordLogin_Call struct {
+	*mock.Call
+}
+
+// RecordLogin is a helper method to define mock.On call
+//   - id int
+func (_e *MockRepository_Expecter) RecordLogin(id interface{}) *MockRepository_RecordLogin_Call {
+	return &MockRepository_RecordLogin_Call{Call: _e.mock.On("RecordLogin", id)}
+}
+
+func (_c *MockRepository_RecordLogin_Call) Run(run func(id int)) *MockRepository_RecordLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RecordLogin_Call) Return(_a0 error) *MockRepository_RecordLogin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RecordLogin_Call) RunAndReturn(run func(int) error) *MockRepository_RecordLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: user
 func (_m *MockRepository) Update(user *users.User) error {
 	ret := _m.Called(user)
@@ -332,6 +552,62 @@ func (_c *MockRepository_Update_Call) RunAndReturn(run func(*users.User) error)
 	return _c
 }
 
+// UsernameExists provides a mock function with given fields: username
+func (_m *MockRepository) UsernameExists(username string) (bool, error) {
+	ret := _m.Called(username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UsernameExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(username)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(username)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_UsernameExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UsernameExists'
+type MockRepository_UsernameExists_Call struct {
+	*mock.Call
+}
+
+// UsernameExists is a helper method to define mock.On call
+//   - username string
+func (_e *MockRepository_Expecter) UsernameExists(username interface{}) *MockRepository_UsernameExists_Call {
+	return &MockRepository_UsernameExists_Call{Call: _e.mock.On("UsernameExists", username)}
+}
+
+func (_c *MockRepository_UsernameExists_Call) Run(run func(username string)) *MockRepository_UsernameExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UsernameExists_Call) Return(_a0 bool, _a1 error) *MockRepository_UsernameExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_UsernameExists_Call) RunAndReturn(run func(string) (bool, error)) *MockRepository_UsernameExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepository(t interface {
@@ -0,0 +1,140 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockVerificationTokenRepository is an autogenerated mock type for the VerificationTokenRepository type
+type MockVerificationTokenRepository struct {
+	mock.Mock
+}
+
+type MockVerificationTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVerificationTokenRepository) EXPECT() *MockVerificationTokenRepository_Expecter {
+	return &MockVerificationTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: userID, tokenHash, expiresAt
+func (_m *MockVerificationTokenRepository) Create(userID int, tokenHash string, expiresAt time.Time) error {
+	ret := _m.Called(userID, tokenHash, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) error); ok {
+		r0 = rf(userID, tokenHash, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVerificationTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVerificationTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - userID int
+//   - tokenHash string
+//   - expiresAt time.Time
+func (_e *MockVerificationTokenRepository_Expecter) Create(userID interface{}, tokenHash interface{}, expiresAt interface{}) *MockVerificationTokenRepository_Create_Call {
+	return &MockVerificationTokenRepository_Create_Call{Call: _e.mock.On("Create", userID, tokenHash, expiresAt)}
+}
+
+func (_c *MockVerificationTokenRepository_Create_Call) Run(run func(userID int, tokenHash string, expiresAt time.Time)) *MockVerificationTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockVerificationTokenRepository_Create_Call) Return(_a0 error) *MockVerificationTokenRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVerificationTokenRepository_Create_Call) RunAndReturn(run func(int, string, time.Time) error) *MockVerificationTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: tokenHash
+func (_m *MockVerificationTokenRepository) Verify(tokenHash string) (int, error) {
+	ret := _m.Called(tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(tokenHash)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVerificationTokenRepository_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockVerificationTokenRepository_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - tokenHash string
+func (_e *MockVerificationTokenRepository_Expecter) Verify(tokenHash interface{}) *MockVerificationTokenRepository_Verify_Call {
+	return &MockVerificationTokenRepository_Verify_Call{Call: _e.mock.On("Verify", tokenHash)}
+}
+
+func (_c *MockVerificationTokenRepository_Verify_Call) Run(run func(tokenHash string)) *MockVerificationTokenRepository_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockVerificationTokenRepository_Verify_Call) Return(_a0 int, _a1 error) *MockVerificationTokenRepository_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVerificationTokenRepository_Verify_Call) RunAndReturn(run func(string) (int, error)) *MockVerificationTokenRepository_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVerificationTokenRepository creates a new instance of MockVerificationTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVerificationTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVerificationTokenRepository {
+	mock := &MockVerificationTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
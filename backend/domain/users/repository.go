@@ -1,11 +1,25 @@
 package users
 
+import "time"
+
 // Repository defines the interface for user data operations
 type Repository interface {
 	Create(user *User) error
 	GetByID(id int) (*User, error)
 	GetByEmail(email string) (*User, error)
 	GetByUsername(username string) (*User, error)
+	GetAll() ([]User, error)
+	EmailExists(email string) (bool, error)
+	UsernameExists(username string) (bool, error)
 	Update(user *User) error
 	Delete(id int) error
-}
\ No newline at end of file
+	RecordLogin(id int) error
+	GetPreviousLoginAt(id int) (*time.Time, error)
+}
+// VerificationTokenRepository stores email verification tokens by their hash
+type VerificationTokenRepository interface {
+	Create(userID int, tokenHash string, expiresAt time.Time) error
+	// Verify consumes an unexpired token and marks its user's email verified, returning the
+	// user ID; it returns "verification token not found" for unknown, used or expired tokens
+	Verify(tokenHash string) (int, error)
+}
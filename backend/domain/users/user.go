@@ -4,45 +4,101 @@ import (
 	"time"
 )
 
+// User roles; every user has exactly one
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// ValidRole reports whether role is one of the known roles
+func ValidRole(role string) bool {
+	return role == RoleUser || role == RoleAdmin
+}
+
 // User represents the core user entity
 type User struct {
-	ID           int       `json:"id"`
-	Username     string    `json:"username"`
-	Email        string    `json:"email"`
-	PasswordHash string    `json:"-"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID            int       `json:"id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	PasswordHash  string    `json:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
-// CreateUserRequest represents the data needed to create a user
+// CreateUserRequest represents the data needed to create a user; the password is checked
+// against the configured password policy
 type CreateUserRequest struct {
 	Username string `json:"username" binding:"required,min=3,max=50"`
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=6"`
+	Password string `json:"password" binding:"required"`
 }
 
-// LoginRequest represents the data needed for user authentication
+// LoginRequest represents the data needed for user authentication; the user is identified
+// by either Email or Username, with Email taking precedence when both are given
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
+	Email    string `json:"email" binding:"omitempty,email"`
+	Username string `json:"username"`
 	Password string `json:"password" binding:"required"`
 }
 
+// RefreshTokenRequest represents the data needed to exchange a refresh token for an access token
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LogoutRequest optionally carries the session's refresh token so logout can revoke it too
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // UserResponse represents the user data returned to clients
 type UserResponse struct {
-	ID        int       `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            int       `json:"id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // ToResponse converts a User to UserResponse
 func (u *User) ToResponse() *UserResponse {
 	return &UserResponse{
-		ID:        u.ID,
-		Username:  u.Username,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:            u.ID,
+		Username:      u.Username,
+		Email:         u.Email,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
 	}
-}
\ No newline at end of file
+}
+
+// PublicProfileResponse represents the public view of a user, without private fields
+type PublicProfileResponse struct {
+	ID            int       `json:"id"`
+	Username      string    `json:"username"`
+	JoinedAt      time.Time `json:"joined_at"`
+	FeatureCount  int       `json:"feature_count"`
+	VotesReceived int       `json:"votes_received"`
+}
+
+// ToPublicProfile converts a User to PublicProfileResponse with the given stats
+func (u *User) ToPublicProfile(featureCount, votesReceived int) *PublicProfileResponse {
+	return &PublicProfileResponse{
+		ID:            u.ID,
+		Username:      u.Username,
+		JoinedAt:      u.CreatedAt,
+		FeatureCount:  featureCount,
+		VotesReceived: votesReceived,
+	}
+}
+
+// ActivityStats summarises a user's own activity for their profile
+type ActivityStats struct {
+	FeaturesCreated int `json:"features_created"`
+	VotesCast       int `json:"votes_cast"`
+}
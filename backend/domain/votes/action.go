@@ -0,0 +1,18 @@
+package votes
+
+import "time"
+
+// Vote action kinds recorded for undo
+const (
+	ActionAdd    = "add"
+	ActionRemove = "remove"
+)
+
+// VoteAction is the most recent vote change a user made
+type VoteAction struct {
+	UserID    int       `json:"user_id"`
+	FeatureID int       `json:"feature_id"`
+	Category  string    `json:"category"`
+	Action    string    `json:"action"`
+	CreatedAt time.Time `json:"created_at"`
+}
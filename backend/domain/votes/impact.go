@@ -0,0 +1,33 @@
+package votes
+
+import "github.com/feature-voting-platform/backend/domain/activity"
+
+// FeatureVoteImpact describes how a feature's vote count would change if a user's votes were removed
+type FeatureVoteImpact struct {
+	FeatureID          int    `json:"feature_id"`
+	Title              string `json:"title"`
+	CurrentVoteCount   int    `json:"current_vote_count"`
+	VotesRemoved       int    `json:"votes_removed"`
+	ProjectedVoteCount int    `json:"projected_vote_count"`
+	DropsBelow         []int  `json:"drops_below,omitempty"`
+}
+
+// VoteImpact previews the effect of removing all of a user's votes. Nothing is changed.
+type VoteImpact struct {
+	UserID       int                 `json:"user_id"`
+	TotalVotes   int                 `json:"total_votes"`
+	Features     []FeatureVoteImpact `json:"features"`
+	DroppedCount int                 `json:"dropped_count"`
+}
+
+// ThresholdsCrossed returns the vote milestones a feature would fall below when its
+// count drops from current to projected, lowest first
+func ThresholdsCrossed(current, projected int) []int {
+	var crossed []int
+	for _, milestone := range activity.VoteMilestones {
+		if projected < milestone && current >= milestone {
+			crossed = append(crossed, milestone)
+		}
+	}
+	return crossed
+}
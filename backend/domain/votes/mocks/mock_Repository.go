@@ -20,22 +20,32 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
-// AddVote provides a mock function with given fields: userID, featureID
-func (_m *MockRepository) AddVote(userID int, featureID int) error {
-	ret := _m.Called(userID, featureID)
+// AddVote provides a mock function with given fields: userID, featureID, category, value, reason
+func (_m *MockRepository) AddVote(userID int, featureID int, category string, value int, reason *string) (bool, error) {
+	ret := _m.Called(userID, featureID, category, value, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddVote")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(int, int) error); ok {
-		r0 = rf(userID, featureID)
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string, int, *string) (bool, error)); ok {
+		return rf(userID, featureID, category, value, reason)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, int, *string) bool); ok {
+		r0 = rf(userID, featureID, category, value, reason)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(int, int, string, int, *string) error); ok {
+		r1 = rf(userID, featureID, category, value, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockRepository_AddVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVote'
@@ -46,23 +56,259 @@ type MockRepository_AddVote_Call struct {
 // AddVote is a helper method to define mock.On call
 //   - userID int
 //   - featureID int
-func (_e *MockRepository_Expecter) AddVote(userID interface{}, featureID interface{}) *MockRepository_AddVote_Call {
-	return &MockRepository_AddVote_Call{Call: _e.mock.On("AddVote", userID, featureID)}
+//   - category string
+//   - value int
+//   - reason *string
+func (_e *MockRepository_Expecter) AddVote(userID interface{}, featureID interface{}, category interface{}, value interface{}, reason interface{}) *MockRepository_AddVote_Call {
+	return &MockRepository_AddVote_Call{Call: _e.mock.On("AddVote", userID, featureID, category, value, reason)}
 }
 
-func (_c *MockRepository_AddVote_Call) Run(run func(userID int, featureID int)) *MockRepository_AddVote_Call {
+func (_c *MockRepository_AddVote_Call) Run(run func(userID int, featureID int, category string, value int, reason *string)) *MockRepository_AddVote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(string), args[3].(int), args[4].(*string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_AddVote_Call) Return(_a0 error) *MockRepository_AddVote_Call {
-	_c.Call.Return(_a0)
+func (_c *MockRepository_AddVote_Call) Return(_a0 bool, _a1 error) *MockRepository_AddVote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_AddVote_Call) RunAndReturn(run func(int, int, string, int, *string) (bool, error)) *MockRepository_AddVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeVoteDirection provides a mock function with given fields: userID, featureID, category, value
+func (_m *MockRepository) ChangeVoteDirection(userID int, featureID int, category string, value int) (bool, error) {
+	ret := _m.Called(userID, featureID, category, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeVoteDirection")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string, int) (bool, error)); ok {
+		return rf(userID, featureID, category, value)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, int) bool); ok {
+		r0 = rf(userID, featureID, category, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, int) error); ok {
+		r1 = rf(userID, featureID, category, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ChangeVoteDirection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeVoteDirection'
+type MockRepository_ChangeVoteDirection_Call struct {
+	*mock.Call
+}
+
+// ChangeVoteDirection is a helper method to define mock.On call
+//   - userID int
+//   - featureID int
+//   - category string
+//   - value int
+func (_e *MockRepository_Expecter) ChangeVoteDirection(userID interface{}, featureID interface{}, category interface{}, value interface{}) *MockRepository_ChangeVoteDirection_Call {
+	return &MockRepository_ChangeVoteDirection_Call{Call: _e.mock.On("ChangeVoteDirection", userID, featureID, category, value)}
+}
+
+func (_c *MockRepository_ChangeVoteDirection_Call) Run(run func(userID int, featureID int, category string, value int)) *MockRepository_ChangeVoteDirection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ChangeVoteDirection_Call) Return(_a0 bool, _a1 error) *MockRepository_ChangeVoteDirection_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_AddVote_Call) RunAndReturn(run func(int, int) error) *MockRepository_AddVote_Call {
+func (_c *MockRepository_ChangeVoteDirection_Call) RunAndReturn(run func(int, int, string, int) (bool, error)) *MockRepository_ChangeVoteDirection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByUser provides a mock function with given fields: userID
+func (_m *MockRepository) CountByUser(userID int) (int, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUser")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUser'
+type MockRepository_CountByUser_Call struct {
+	*mock.Call
+}
+
+// CountByUser is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) CountByUser(userID interface{}) *MockRepository_CountByUser_Call {
+	return &MockRepository_CountByUser_Call{Call: _e.mock.On("CountByUser", userID)}
+}
+
+func (_c *MockRepository_CountByUser_Call) Run(run func(userID int)) *MockRepository_CountByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountByUser_Call) Return(_a0 int, _a1 error) *MockRepository_CountByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountByUser_Call) RunAndReturn(run func(int) (int, error)) *MockRepository_CountByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastVoteAction provides a mock function with given fields: userID
+func (_m *MockRepository) GetLastVoteAction(userID int) (*votes.VoteAction, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastVoteAction")
+	}
+
+	var r0 *votes.VoteAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*votes.VoteAction, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *votes.VoteAction); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*votes.VoteAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetLastVoteAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastVoteAction'
+type MockRepository_GetLastVoteAction_Call struct {
+	*mock.Call
+}
+
+// GetLastVoteAction is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) GetLastVoteAction(userID interface{}) *MockRepository_GetLastVoteAction_Call {
+	return &MockRepository_GetLastVoteAction_Call{Call: _e.mock.On("GetLastVoteAction", userID)}
+}
+
+func (_c *MockRepository_GetLastVoteAction_Call) Run(run func(userID int)) *MockRepository_GetLastVoteAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetLastVoteAction_Call) Return(_a0 *votes.VoteAction, _a1 error) *MockRepository_GetLastVoteAction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetLastVoteAction_Call) RunAndReturn(run func(int) (*votes.VoteAction, error)) *MockRepository_GetLastVoteAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserVote provides a mock function with given fields: userID, featureID, category
+func (_m *MockRepository) GetUserVote(userID int, featureID int, category string) (*votes.Vote, error) {
+	ret := _m.Called(userID, featureID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserVote")
+	}
+
+	var r0 *votes.Vote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string) (*votes.Vote, error)); ok {
+		return rf(userID, featureID, category)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) *votes.Vote); ok {
+		r0 = rf(userID, featureID, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*votes.Vote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) error); ok {
+		r1 = rf(userID, featureID, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetUserVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserVote'
+type MockRepository_GetUserVote_Call struct {
+	*mock.Call
+}
+
+// GetUserVote is a helper method to define mock.On call
+//   - userID int
+//   - featureID int
+//   - category string
+func (_e *MockRepository_Expecter) GetUserVote(userID interface{}, featureID interface{}, category interface{}) *MockRepository_GetUserVote_Call {
+	return &MockRepository_GetUserVote_Call{Call: _e.mock.On("GetUserVote", userID, featureID, category)}
+}
+
+func (_c *MockRepository_GetUserVote_Call) Run(run func(userID int, featureID int, category string)) *MockRepository_GetUserVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetUserVote_Call) Return(_a0 *votes.Vote, _a1 error) *MockRepository_GetUserVote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetUserVote_Call) RunAndReturn(run func(int, int, string) (*votes.Vote, error)) *MockRepository_GetUserVote_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -125,27 +371,202 @@ func (_c *MockRepository_GetUserVotes_Call) RunAndReturn(run func(int) ([]votes.
 	return _c
 }
 
-// HasUserVoted provides a mock function with given fields: userID, featureID
-func (_m *MockRepository) HasUserVoted(userID int, featureID int) (bool, error) {
-	ret := _m.Called(userID, featureID)
+// GetUserVotesWithFeatures provides a mock function with given fields: userID
+func (_m *MockRepository) GetUserVotesWithFeatures(userID int) ([]votes.VoteWithFeature, error) {
+	ret := _m.Called(userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HasUserVoted")
+		panic("no return value specified for GetUserVotesWithFeatures")
 	}
 
-	var r0 bool
+	var r0 []votes.VoteWithFeature
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (bool, error)); ok {
-		return rf(userID, featureID)
+	if rf, ok := ret.Get(0).(func(int) ([]votes.VoteWithFeature, error)); ok {
+		return rf(userID)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) bool); ok {
-		r0 = rf(userID, featureID)
+	if rf, ok := ret.Get(0).(func(int) []votes.VoteWithFeature); ok {
+		r0 = rf(userID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]votes.VoteWithFeature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetUserVotesWithFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserVotesWithFeatures'
+type MockRepository_GetUserVotesWithFeatures_Call struct {
+	*mock.Call
+}
+
+// GetUserVotesWithFeatures is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) GetUserVotesWithFeatures(userID interface{}) *MockRepository_GetUserVotesWithFeatures_Call {
+	return &MockRepository_GetUserVotesWithFeatures_Call{Call: _e.mock.On("GetUserVotesWithFeatures", userID)}
+}
+
+func (_c *MockRepository_GetUserVotesWithFeatures_Call) Run(run func(userID int)) *MockRepository_GetUserVotesWithFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetUserVotesWithFeatures_Call) Return(_a0 []votes.VoteWithFeature, _a1 error) *MockRepository_GetUserVotesWithFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetUserVotesWithFeatures_Call) RunAndReturn(run func(int) ([]votes.VoteWithFeature, error)) *MockRepository_GetUserVotesWithFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVoteOverlap provides a mock function with given fields: userID, otherUserID
+func (_m *MockRepository) GetVoteOverlap(userID int, otherUserID int) (*votes.VoteOverlap, error) {
+	ret := _m.Called(userID, otherUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoteOverlap")
+	}
+
+	var r0 *votes.VoteOverlap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*votes.VoteOverlap, error)); ok {
+		return rf(userID, otherUserID)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *votes.VoteOverlap); ok {
+		r0 = rf(userID, otherUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*votes.VoteOverlap)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(userID, featureID)
+		r1 = rf(userID, otherUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetVoteOverlap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoteOverlap'
+type MockRepository_GetVoteOverlap_Call struct {
+	*mock.Call
+}
+
+// GetVoteOverlap is a helper method to define mock.On call
+//   - userID int
+//   - otherUserID int
+func (_e *MockRepository_Expecter) GetVoteOverlap(userID interface{}, otherUserID interface{}) *MockRepository_GetVoteOverlap_Call {
+	return &MockRepository_GetVoteOverlap_Call{Call: _e.mock.On("GetVoteOverlap", userID, otherUserID)}
+}
+
+func (_c *MockRepository_GetVoteOverlap_Call) Run(run func(userID int, otherUserID int)) *MockRepository_GetVoteOverlap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVoteOverlap_Call) Return(_a0 *votes.VoteOverlap, _a1 error) *MockRepository_GetVoteOverlap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetVoteOverlap_Call) RunAndReturn(run func(int, int) (*votes.VoteOverlap, error)) *MockRepository_GetVoteOverlap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVoteRemovalImpact provides a mock function with given fields: userID
+func (_m *MockRepository) GetVoteRemovalImpact(userID int) (*votes.VoteImpact, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoteRemovalImpact")
+	}
+
+	var r0 *votes.VoteImpact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*votes.VoteImpact, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *votes.VoteImpact); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*votes.VoteImpact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetVoteRemovalImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoteRemovalImpact'
+type MockRepository_GetVoteRemovalImpact_Call struct {
+	*mock.Call
+}
+
+// GetVoteRemovalImpact is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) GetVoteRemovalImpact(userID interface{}) *MockRepository_GetVoteRemovalImpact_Call {
+	return &MockRepository_GetVoteRemovalImpact_Call{Call: _e.mock.On("GetVoteRemovalImpact", userID)}
+}
+
+func (_c *MockRepository_GetVoteRemovalImpact_Call) Run(run func(userID int)) *MockRepository_GetVoteRemovalImpact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVoteRemovalImpact_Call) Return(_a0 *votes.VoteImpact, _a1 error) *MockRepository_GetVoteRemovalImpact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetVoteRemovalImpact_Call) RunAndReturn(run func(int) (*votes.VoteImpact, error)) *MockRepository_GetVoteRemovalImpact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVoterPercentile provides a mock function with given fields: userID
+func (_m *MockRepository) GetVoterPercentile(userID int) (float64, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoterPercentile")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (float64, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) float64); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,46 +574,219 @@ func (_m *MockRepository) HasUserVoted(userID int, featureID int) (bool, error)
 	return r0, r1
 }
 
-// MockRepository_HasUserVoted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasUserVoted'
-type MockRepository_HasUserVoted_Call struct {
+// MockRepository_GetVoterPercentile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoterPercentile'
+type MockRepository_GetVoterPercentile_Call struct {
 	*mock.Call
 }
 
-// HasUserVoted is a helper method to define mock.On call
+// GetVoterPercentile is a helper method to define mock.On call
 //   - userID int
+func (_e *MockRepository_Expecter) GetVoterPercentile(userID interface{}) *MockRepository_GetVoterPercentile_Call {
+	return &MockRepository_GetVoterPercentile_Call{Call: _e.mock.On("GetVoterPercentile", userID)}
+}
+
+func (_c *MockRepository_GetVoterPercentile_Call) Run(run func(userID int)) *MockRepository_GetVoterPercentile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVoterPercentile_Call) Return(_a0 float64, _a1 error) *MockRepository_GetVoterPercentile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetVoterPercentile_Call) RunAndReturn(run func(int) (float64, error)) *MockRepository_GetVoterPercentile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVotersByFeature provides a mock function with given fields: featureID, limit
+func (_m *MockRepository) GetVotersByFeature(featureID int, limit int) ([]votes.VoterInfo, error) {
+	ret := _m.Called(featureID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVotersByFeature")
+	}
+
+	var r0 []votes.VoterInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]votes.VoterInfo, error)); ok {
+		return rf(featureID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []votes.VoterInfo); ok {
+		r0 = rf(featureID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]votes.VoterInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(featureID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetVotersByFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVotersByFeature'
+type MockRepository_GetVotersByFeature_Call struct {
+	*mock.Call
+}
+
+// GetVotersByFeature is a helper method to define mock.On call
 //   - featureID int
-func (_e *MockRepository_Expecter) HasUserVoted(userID interface{}, featureID interface{}) *MockRepository_HasUserVoted_Call {
-	return &MockRepository_HasUserVoted_Call{Call: _e.mock.On("HasUserVoted", userID, featureID)}
+//   - limit int
+func (_e *MockRepository_Expecter) GetVotersByFeature(featureID interface{}, limit interface{}) *MockRepository_GetVotersByFeature_Call {
+	return &MockRepository_GetVotersByFeature_Call{Call: _e.mock.On("GetVotersByFeature", featureID, limit)}
 }
 
-func (_c *MockRepository_HasUserVoted_Call) Run(run func(userID int, featureID int)) *MockRepository_HasUserVoted_Call {
+func (_c *MockRepository_GetVotersByFeature_Call) Run(run func(featureID int, limit int)) *MockRepository_GetVotersByFeature_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(int), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *MockRepository_HasUserVoted_Call) Return(_a0 bool, _a1 error) *MockRepository_HasUserVoted_Call {
+func (_c *MockRepository_GetVotersByFeature_Call) Return(_a0 []votes.VoterInfo, _a1 error) *MockRepository_GetVotersByFeature_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_HasUserVoted_Call) RunAndReturn(run func(int, int) (bool, error)) *MockRepository_HasUserVoted_Call {
+func (_c *MockRepository_GetVotersByFeature_Call) RunAndReturn(run func(int, int) ([]votes.VoterInfo, error)) *MockRepository_GetVotersByFeature_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveVote provides a mock function with given fields: userID, featureID
-func (_m *MockRepository) RemoveVote(userID int, featureID int) error {
-	ret := _m.Called(userID, featureID)
+// GetVotingStreak provides a mock function with given fields: userID
+func (_m *MockRepository) GetVotingStreak(userID int) (int, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVotingStreak")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetVotingStreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVotingStreak'
+type MockRepository_GetVotingStreak_Call struct {
+	*mock.Call
+}
+
+// GetVotingStreak is a helper method to define mock.On call
+//   - userID int
+func (_e *MockRepository_Expecter) GetVotingStreak(userID interface{}) *MockRepository_GetVotingStreak_Call {
+	return &MockRepository_GetVotingStreak_Call{Call: _e.mock.On("GetVotingStreak", userID)}
+}
+
+func (_c *MockRepository_GetVotingStreak_Call) Run(run func(userID int)) *MockRepository_GetVotingStreak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVotingStreak_Call) Return(_a0 int, _a1 error) *MockRepository_GetVotingStreak_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetVotingStreak_Call) RunAndReturn(run func(int) (int, error)) *MockRepository_GetVotingStreak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasUserVotedBulk provides a mock function with given fields: userID, featureIDs
+func (_m *MockRepository) HasUserVotedBulk(userID int, featureIDs []int) (map[int]bool, error) {
+	ret := _m.Called(userID, featureIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasUserVotedBulk")
+	}
+
+	var r0 map[int]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, []int) (map[int]bool, error)); ok {
+		return rf(userID, featureIDs)
+	}
+	if rf, ok := ret.Get(0).(func(int, []int) map[int]bool); ok {
+		r0 = rf(userID, featureIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
+		r1 = rf(userID, featureIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_HasUserVotedBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasUserVotedBulk'
+type MockRepository_HasUserVotedBulk_Call struct {
+	*mock.Call
+}
+
+// HasUserVotedBulk is a helper method to define mock.On call
+//   - userID int
+//   - featureIDs []int
+func (_e *MockRepository_Expecter) HasUserVotedBulk(userID interface{}, featureIDs interface{}) *MockRepository_HasUserVotedBulk_Call {
+	return &MockRepository_HasUserVotedBulk_Call{Call: _e.mock.On("HasUserVotedBulk", userID, featureIDs)}
+}
+
+func (_c *MockRepository_HasUserVotedBulk_Call) Run(run func(userID int, featureIDs []int)) *MockRepository_HasUserVotedBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_HasUserVotedBulk_Call) Return(_a0 map[int]bool, _a1 error) *MockRepository_HasUserVotedBulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_HasUserVotedBulk_Call) RunAndReturn(run func(int, []int) (map[int]bool, error)) *MockRepository_HasUserVotedBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVote provides a mock function with given fields: userID, featureID, category
+func (_m *MockRepository) RemoveVote(userID int, featureID int, category string) error {
+	ret := _m.Called(userID, featureID, category)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RemoveVote")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int, int) error); ok {
-		r0 = rf(userID, featureID)
+	if rf, ok := ret.Get(0).(func(int, int, string) error); ok {
+		r0 = rf(userID, featureID, category)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -208,13 +802,14 @@ type MockRepository_RemoveVote_Call struct {
 // RemoveVote is a helper method to define mock.On call
 //   - userID int
 //   - featureID int
-func (_e *MockRepository_Expecter) RemoveVote(userID interface{}, featureID interface{}) *MockRepository_RemoveVote_Call {
-	return &MockRepository_RemoveVote_Call{Call: _e.mock.On("RemoveVote", userID, featureID)}
+//   - category string
+func (_e *MockRepository_Expecter) RemoveVote(userID interface{}, featureID interface{}, category interface{}) *MockRepository_RemoveVote_Call {
+	return &MockRepository_RemoveVote_Call{Call: _e.mock.On("RemoveVote", userID, featureID, category)}
 }
 
-func (_c *MockRepository_RemoveVote_Call) Run(run func(userID int, featureID int)) *MockRepository_RemoveVote_Call {
+func (_c *MockRepository_RemoveVote_Call) Run(run func(userID int, featureID int, category string)) *MockRepository_RemoveVote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(string))
 	})
 	return _c
 }
@@ -224,7 +819,112 @@ func (_c *MockRepository_RemoveVote_Call) Return(_a0 error) *MockRepository_Remo
 	return _c
 }
 
-func (_c *MockRepository_RemoveVote_Call) RunAndReturn(run func(int, int) error) *MockRepository_RemoveVote_Call {
+func (_c *MockRepository_RemoveVote_Call) RunAndReturn(run func(int, int, string) error) *MockRepository_RemoveVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVotes provides a mock function with given fields: userID, featureIDs
+func (_m *MockRepository) RemoveVotes(userID int, featureIDs []int) (int, error) {
+	ret := _m.Called(userID, featureIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveVotes")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, []int) (int, error)); ok {
+		return rf(userID, featureIDs)
+	}
+	if rf, ok := ret.Get(0).(func(int, []int) int); ok {
+		r0 = rf(userID, featureIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
+		r1 = rf(userID, featureIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_RemoveVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveVotes'
+type MockRepository_RemoveVotes_Call struct {
+	*mock.Call
+}
+
+// RemoveVotes is a helper method to define mock.On call
+//   - userID int
+//   - featureIDs []int
+func (_e *MockRepository_Expecter) RemoveVotes(userID interface{}, featureIDs interface{}) *MockRepository_RemoveVotes_Call {
+	return &MockRepository_RemoveVotes_Call{Call: _e.mock.On("RemoveVotes", userID, featureIDs)}
+}
+
+func (_c *MockRepository_RemoveVotes_Call) Run(run func(userID int, featureIDs []int)) *MockRepository_RemoveVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveVotes_Call) Return(_a0 int, _a1 error) *MockRepository_RemoveVotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_RemoveVotes_Call) RunAndReturn(run func(int, []int) (int, error)) *MockRepository_RemoveVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndoVote provides a mock function with given fields: userID, featureID, category
+func (_m *MockRepository) UndoVote(userID int, featureID int, category string) error {
+	ret := _m.Called(userID, featureID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndoVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int, string) error); ok {
+		r0 = rf(userID, featureID, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UndoVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndoVote'
+type MockRepository_UndoVote_Call struct {
+	*mock.Call
+}
+
+// UndoVote is a helper method to define mock.On call
+//   - userID int
+//   - featureID int
+//   - category string
+func (_e *MockRepository_Expecter) UndoVote(userID interface{}, featureID interface{}, category interface{}) *MockRepository_UndoVote_Call {
+	return &MockRepository_UndoVote_Call{Call: _e.mock.On("UndoVote", userID, featureID, category)}
+}
+
+func (_c *MockRepository_UndoVote_Call) Run(run func(userID int, featureID int, category string)) *MockRepository_UndoVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UndoVote_Call) Return(_a0 error) *MockRepository_UndoVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UndoVote_Call) RunAndReturn(run func(int, int, string) error) *MockRepository_UndoVote_Call {
 	_c.Call.Return(run)
 	return _c
 }
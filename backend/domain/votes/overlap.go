@@ -0,0 +1,15 @@
+package votes
+
+import "github.com/feature-voting-platform/backend/domain/features"
+
+// VoteOverlap compares the votes of two users. Only the shared features are listed;
+// the features only one of them voted for are reported as counts so neither user's
+// other votes are revealed.
+type VoteOverlap struct {
+	UserID         int                `json:"user_id"`
+	OtherUserID    int                `json:"other_user_id"`
+	SharedFeatures []features.Feature `json:"shared_features"`
+	SharedCount    int                `json:"shared_count"`
+	OnlyUserCount  int                `json:"only_user_count"`
+	OnlyOtherCount int                `json:"only_other_count"`
+}
@@ -2,8 +2,20 @@ package votes
 
 // Repository defines the interface for vote data operations
 type Repository interface {
-	AddVote(userID, featureID int) error
-	RemoveVote(userID, featureID int) error
-	HasUserVoted(userID, featureID int) (bool, error)
+	AddVote(userID, featureID int, category string, value int, reason *string) (bool, error)
+	ChangeVoteDirection(userID, featureID int, category string, value int) (bool, error)
+	RemoveVote(userID, featureID int, category string) error
+	RemoveVotes(userID int, featureIDs []int) (int, error)
+	GetUserVote(userID, featureID int, category string) (*Vote, error)
+	HasUserVotedBulk(userID int, featureIDs []int) (map[int]bool, error)
 	GetUserVotes(userID int) ([]Vote, error)
+	GetUserVotesWithFeatures(userID int) ([]VoteWithFeature, error)
+	GetVotersByFeature(featureID int, limit int) ([]VoterInfo, error)
+	CountByUser(userID int) (int, error)
+	GetVoteOverlap(userID, otherUserID int) (*VoteOverlap, error)
+	GetLastVoteAction(userID int) (*VoteAction, error)
+	UndoVote(userID, featureID int, category string) error
+	GetVotingStreak(userID int) (int, error)
+	GetVoterPercentile(userID int) (float64, error)
+	GetVoteRemovalImpact(userID int) (*VoteImpact, error)
 }
\ No newline at end of file
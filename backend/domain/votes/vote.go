@@ -1,18 +1,85 @@
 package votes
 
 import (
+	"errors"
 	"time"
 )
 
+// MaxReasonLength is the maximum number of characters allowed in a vote reason
+const MaxReasonLength = 280
+
+// DefaultCategory is the category of votes cast without naming one
+const DefaultCategory = "default"
+
+// Vote values; a feature's vote count is the sum of the values of its votes
+const (
+	Upvote   = 1
+	Downvote = -1
+)
+
+// ParseDirection maps a vote request direction to a vote value; an empty direction is an upvote
+func ParseDirection(direction string) (int, bool) {
+	switch direction {
+	case "", "up":
+		return Upvote, true
+	case "down":
+		return Downvote, true
+	}
+	return 0, false
+}
+
+// ErrVotingClosed is returned when voting on a feature whose deadline has passed or that is
+// completed or rejected
+var ErrVotingClosed = errors.New("voting closed")
+
 // Vote represents the core vote entity
 type Vote struct {
 	ID        int       `json:"id"`
 	UserID    int       `json:"user_id"`
 	FeatureID int       `json:"feature_id"`
+	Category  string    `json:"category"`
+	Value     int       `json:"value"`
+	Reason    *string   `json:"reason,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// VotedFeature summarizes the feature a vote was cast on
+type VotedFeature struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	VoteCount int       `json:"vote_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// VoteWithFeature is a vote together with a summary of the feature it was cast on
+type VoteWithFeature struct {
+	Vote
+	Feature VotedFeature `json:"feature"`
+}
+
+// VoterInfo describes a vote on a feature by who cast it; only the public username is
+// included, never the email
+type VoterInfo struct {
+	UserID   int       `json:"user_id"`
+	Username string    `json:"username"`
+	Category string    `json:"category"`
+	Value    int       `json:"value"`
+	Reason   *string   `json:"reason,omitempty"`
+	VotedAt  time.Time `json:"voted_at"`
+}
+
+// CastVoteRequest represents the optional body accepted when voting for a feature
+type CastVoteRequest struct {
+	Reason    *string `json:"reason"`
+	Category  string  `json:"category"`
+	Direction string  `json:"direction"`
+}
+
 // VoteRequest represents the data needed to cast a vote
 type VoteRequest struct {
 	FeatureID int `json:"feature_id" binding:"required"`
-}
\ No newline at end of file
+}
+// BulkRemoveRequest represents the features to remove the user's votes from
+type BulkRemoveRequest struct {
+	FeatureIDs []int `json:"feature_ids" binding:"required,min=1,max=100"`
+}
@@ -0,0 +1,38 @@
+// Package version exposes build metadata injected at link time, e.g.
+//
+//	go build -ldflags "-X github.com/feature-voting-platform/backend/internal/version.Version=v1.2.0"
+package version
+
+import "runtime"
+
+// Set via -ldflags "-X"; left empty for local builds
+var (
+	Version   = ""
+	GitCommit = ""
+	BuildTime = ""
+)
+
+// Info describes the running build
+type Info struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"git_commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build info, substituting "dev"/"unknown" for values not set at link time
+func Get() Info {
+	return Info{
+		Version:   orDefault(Version, "dev"),
+		GitCommit: orDefault(GitCommit, "unknown"),
+		BuildTime: orDefault(BuildTime, "unknown"),
+		GoVersion: runtime.Version(),
+	}
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Pin feature text column limits so they match API validation (see domain/features)
+ALTER TABLE features ALTER COLUMN title TYPE VARCHAR(255);
+ALTER TABLE features ADD CONSTRAINT features_description_length
+    CHECK (char_length(description) <= 5000);
+
+-- +migrate Down
+ALTER TABLE features DROP CONSTRAINT IF EXISTS features_description_length;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- Subscriptions let users follow features they did not necessarily vote for
+CREATE TABLE feature_subscriptions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, feature_id)
+);
+
+CREATE INDEX idx_feature_subscriptions_user_created ON feature_subscriptions(user_id, created_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS feature_subscriptions;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- Optional free-text reason a voter can attach to their vote
+ALTER TABLE votes ADD COLUMN reason VARCHAR(280);
+
+-- +migrate Down
+ALTER TABLE votes DROP COLUMN IF EXISTS reason;
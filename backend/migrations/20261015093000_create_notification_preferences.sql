@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Per-user notification toggles; users without a row get the application defaults
+CREATE TABLE notification_preferences (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    vote_on_my_feature BOOLEAN NOT NULL DEFAULT TRUE,
+    status_change BOOLEAN NOT NULL DEFAULT TRUE,
+    subscription_updates BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Lightweight team membership; users sharing a team_id are teammates
+ALTER TABLE users ADD COLUMN team_id INTEGER;
+CREATE INDEX idx_users_team_id ON users(team_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_users_team_id;
+ALTER TABLE users DROP COLUMN IF EXISTS team_id;
@@ -0,0 +1,10 @@
+-- +migrate Up
+-- Optional voting deadline; the expiry job sets expired once expires_at has passed
+ALTER TABLE features ADD COLUMN expires_at TIMESTAMP;
+ALTER TABLE features ADD COLUMN expired BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX idx_features_expires_at ON features(expires_at) WHERE expired = FALSE;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_features_expires_at;
+ALTER TABLE features DROP COLUMN IF EXISTS expired;
+ALTER TABLE features DROP COLUMN IF EXISTS expires_at;
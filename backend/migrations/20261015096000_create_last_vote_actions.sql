@@ -0,0 +1,11 @@
+-- +migrate Up
+-- Each user's most recent vote change, so the latest vote can be undone
+CREATE TABLE last_vote_actions (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('add', 'remove')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS last_vote_actions;
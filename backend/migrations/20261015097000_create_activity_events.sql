@@ -0,0 +1,17 @@
+-- +migrate Up
+-- Global activity stream: feature creations and vote milestones, newest first
+CREATE TABLE activity_events (
+    id SERIAL PRIMARY KEY,
+    type VARCHAR(30) NOT NULL,
+    actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    milestone INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_activity_events_created_at ON activity_events(created_at DESC, id DESC);
+-- A feature announces each vote milestone once, even if votes are toggled around it
+CREATE UNIQUE INDEX idx_activity_events_milestone ON activity_events(feature_id, milestone) WHERE type = 'vote_milestone';
+
+-- +migrate Down
+DROP TABLE IF EXISTS activity_events;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Login times; previous_login_at anchors the "what's new" summary for the current session
+ALTER TABLE users ADD COLUMN last_login_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN previous_login_at TIMESTAMP;
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN IF EXISTS previous_login_at;
+ALTER TABLE users DROP COLUMN IF EXISTS last_login_at;
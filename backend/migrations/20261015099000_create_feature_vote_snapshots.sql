@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Daily copy of every feature's vote count, written by the snapshot job; rank history is
+-- computed from these rows
+CREATE TABLE feature_vote_snapshots (
+    snapshot_date DATE NOT NULL,
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    vote_count INTEGER NOT NULL,
+    PRIMARY KEY (snapshot_date, feature_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS feature_vote_snapshots;
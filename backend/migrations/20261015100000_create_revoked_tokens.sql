@@ -0,0 +1,11 @@
+-- +migrate Up
+-- Tokens revoked before their expiry (logout); rows past expires_at are purged on the next revocation
+CREATE TABLE revoked_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS revoked_tokens;
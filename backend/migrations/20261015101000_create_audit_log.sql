@@ -0,0 +1,20 @@
+-- +migrate Up
+-- One row per audited request, inserted before the handler runs; status and completed_at stay
+-- NULL when the request never finished. actor_id has no foreign key so deleting a user keeps
+-- their trail.
+CREATE TABLE audit_log (
+    id SERIAL PRIMARY KEY,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    body_hash VARCHAR(64) NOT NULL DEFAULT '',
+    actor_id INTEGER,
+    status INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX idx_audit_log_actor_id ON audit_log(actor_id);
+CREATE INDEX idx_audit_log_created_at ON audit_log(created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_log;
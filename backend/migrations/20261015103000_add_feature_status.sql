@@ -0,0 +1,10 @@
+-- +migrate Up
+-- Lifecycle status communicated by product managers; every feature starts open
+ALTER TABLE features ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'open';
+ALTER TABLE features ADD CONSTRAINT features_status_check CHECK (status IN ('open', 'planned', 'in_progress', 'completed', 'rejected'));
+CREATE INDEX idx_features_status ON features(status);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_features_status;
+ALTER TABLE features DROP CONSTRAINT IF EXISTS features_status_check;
+ALTER TABLE features DROP COLUMN IF EXISTS status;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- Votes may be cast in named categories; a user votes at most once per category per feature
+ALTER TABLE votes ADD COLUMN category VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE votes DROP CONSTRAINT IF EXISTS votes_user_id_feature_id_key;
+ALTER TABLE votes ADD CONSTRAINT votes_user_id_feature_id_category_key UNIQUE (user_id, feature_id, category);
+ALTER TABLE last_vote_actions ADD COLUMN category VARCHAR(50) NOT NULL DEFAULT 'default';
+
+-- +migrate Down
+ALTER TABLE last_vote_actions DROP COLUMN IF EXISTS category;
+DELETE FROM votes WHERE category <> 'default';
+UPDATE features f SET vote_count = (SELECT COUNT(*) FROM votes v WHERE v.feature_id = f.id);
+ALTER TABLE votes DROP CONSTRAINT IF EXISTS votes_user_id_feature_id_category_key;
+ALTER TABLE votes ADD CONSTRAINT votes_user_id_feature_id_key UNIQUE (user_id, feature_id);
+ALTER TABLE votes DROP COLUMN IF EXISTS category;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- Comments form a discussion thread on each feature
+CREATE TABLE comments (
+    id SERIAL PRIMARY KEY,
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_comments_feature_created ON comments(feature_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS comments;
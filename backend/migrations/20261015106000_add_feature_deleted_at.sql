@@ -0,0 +1,9 @@
+-- +migrate Up
+-- Deleting a feature only marks it; its votes and comments are kept so it can be restored
+ALTER TABLE features ADD COLUMN deleted_at TIMESTAMP NULL;
+CREATE INDEX idx_features_deleted_at ON features(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_features_deleted_at;
+DELETE FROM features WHERE deleted_at IS NOT NULL;
+ALTER TABLE features DROP COLUMN IF EXISTS deleted_at;
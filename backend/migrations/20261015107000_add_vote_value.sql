@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Votes are upvotes (+1) or downvotes (-1); a feature's vote_count becomes the net score
+ALTER TABLE votes ADD COLUMN value SMALLINT NOT NULL DEFAULT 1 CHECK (value IN (1, -1));
+
+-- +migrate Down
+DELETE FROM votes WHERE value = -1;
+UPDATE features f SET vote_count = (SELECT COUNT(*) FROM votes v WHERE v.feature_id = f.id);
+ALTER TABLE votes DROP COLUMN IF EXISTS value;
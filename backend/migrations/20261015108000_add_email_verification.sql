@@ -0,0 +1,18 @@
+-- +migrate Up
+-- New accounts must confirm their email address; accounts that predate verification are trusted
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE users SET email_verified = TRUE;
+
+-- Only a SHA-256 of each token is stored; a token is deleted when it is used
+CREATE TABLE email_verification_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_email_verification_tokens_expires_at ON email_verification_tokens(expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS email_verification_tokens;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified;
@@ -0,0 +1,20 @@
+-- +migrate Up
+-- Tags group features by area; names are stored lowercase
+CREATE TABLE tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(30) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE feature_tags (
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (feature_id, tag_id)
+);
+
+CREATE INDEX idx_feature_tags_tag_id ON feature_tags(tag_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS feature_tags;
+DROP TABLE IF EXISTS tags;
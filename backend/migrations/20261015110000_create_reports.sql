@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Reports flag features for moderation; each user can report a feature once
+CREATE TABLE reports (
+    id SERIAL PRIMARY KEY,
+    feature_id INTEGER NOT NULL REFERENCES features(id) ON DELETE CASCADE,
+    reporter_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (feature_id, reporter_id)
+);
+
+CREATE INDEX idx_reports_created_at ON reports(created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS reports;
@@ -0,0 +1,27 @@
+// Package migrations embeds the SQL migrations so the binaries carry them with them
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// FS holds every migration file in this directory
+//
+//go:embed *.sql
+var FS embed.FS
+
+// Source returns the embedded migrations as a sql-migrate source
+func Source() migrate.MigrationSource {
+	return &migrate.EmbedFileSystemMigrationSource{
+		FileSystem: FS,
+		Root:       ".",
+	}
+}
+
+// Up applies every pending migration and returns how many were applied
+func Up(db *sql.DB) (int, error) {
+	return migrate.Exec(db, "postgres", Source(), migrate.Up)
+}
@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSContainsAllMigrations(t *testing.T) {
+	files, err := filepath.Glob("*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	found, err := Source().FindMigrations()
+	require.NoError(t, err)
+
+	assert.Len(t, found, len(files))
+	for _, m := range found {
+		assert.NotEmpty(t, m.Up, "migration %s has no up statements", m.Id)
+	}
+}